@@ -0,0 +1,298 @@
+// Package bloom implements the Bloom filter and counting Bloom filter data structures.
+package bloom
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"sync"
+)
+
+const (
+	// wordSize is the number of bits stored in each word of the bit array.
+	wordSize = 64
+
+	// encodingVersion is the version of the binary encoding written by MarshalBinary.
+	encodingVersion = 1
+
+	// headerSize is the size of the binary header: kind, version, k, m and the item count.
+	headerSize = 1 + 1 + 4 + 8 + 8
+
+	// maxHashFunctions bounds the number of hash functions a decoded filter may use. The
+	// optimal number is -log2(p), which stays below 1100 for every positive float64 p, so a
+	// larger one can only come from a corrupted encoding.
+	maxHashFunctions = 1 << 11
+
+	// filterKind and countingKind identify the type of filter in the binary encoding.
+	filterKind   = 'B'
+	countingKind = 'C'
+)
+
+// Filter represents a Bloom filter. A Bloom filter answers whether an item may have been
+// added (with a configurable false-positive rate) or definitely hasn't been added.
+// Mutex ensures the implementation of Filter is thread-safe.
+type Filter struct {
+	bits  []uint64
+	m     uint64 // The number of bits in the filter.
+	k     uint32 // The number of hash functions.
+	count int    // The number of items added to the filter.
+	mu    sync.Mutex
+}
+
+// New creates a new Bloom filter sized to hold expectedItems items while keeping the
+// false-positive rate at or below falsePositiveRate.
+func New(expectedItems int, falsePositiveRate float64) (*Filter, error) {
+	m, k, err := optimalParameters(expectedItems, falsePositiveRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFilter(m, k), nil
+}
+
+// newFilter creates a new Bloom filter with m bits and k hash functions.
+func newFilter(m uint64, k uint32) *Filter {
+	return &Filter{
+		bits: make([]uint64, (m+wordSize-1)/wordSize),
+		m:    m,
+		k:    k,
+	}
+}
+
+// Add adds an item to the filter.
+func (f *Filter) Add(data []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	h1, h2 := hashes(data)
+	for i := range f.k {
+		bit := location(h1, h2, i, f.m)
+		f.bits[bit/wordSize] |= 1 << (bit % wordSize)
+	}
+
+	f.count++
+}
+
+// AddString adds a string item to the filter.
+func (f *Filter) AddString(data string) {
+	f.Add([]byte(data))
+}
+
+// MayContain returns true if the item may have been added to the filter. A false result
+// means the item was definitely never added.
+func (f *Filter) MayContain(data []byte) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	h1, h2 := hashes(data)
+	for i := range f.k {
+		bit := location(h1, h2, i, f.m)
+		if f.bits[bit/wordSize]&(1<<(bit%wordSize)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MayContainString returns true if the string item may have been added to the filter.
+func (f *Filter) MayContainString(data string) bool {
+	return f.MayContain([]byte(data))
+}
+
+// Union returns a new filter containing the items from this filter and the provided filter.
+// Both filters must have been created with the same number of bits and hash functions.
+func (f *Filter) Union(filterB *Filter) (*Filter, error) {
+	// Copy filterB first so both locks are never held at the same time.
+	filterB.mu.Lock()
+	otherBits := append([]uint64(nil), filterB.bits...)
+	otherM, otherK, otherCount := filterB.m, filterB.k, filterB.count
+	filterB.mu.Unlock()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.m != otherM || f.k != otherK {
+		return nil, fmt.Errorf("%w: m=%d k=%d and m=%d k=%d", ErrIncompatibleFilters, f.m, f.k, otherM, otherK)
+	}
+
+	newFilter := newFilter(f.m, f.k)
+	for i := range f.bits {
+		newFilter.bits[i] = f.bits[i] | otherBits[i]
+	}
+
+	newFilter.count = f.count + otherCount
+
+	return newFilter, nil
+}
+
+// EstimatedFalsePositiveRate returns the current false-positive rate estimated from the
+// number of bits that are set.
+func (f *Filter) EstimatedFalsePositiveRate() float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	set := 0
+	for _, word := range f.bits {
+		set += bits.OnesCount64(word)
+	}
+
+	return math.Pow(float64(set)/float64(f.m), float64(f.k))
+}
+
+// Bits returns the number of bits in the filter.
+func (f *Filter) Bits() uint64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.m
+}
+
+// HashFunctions returns the number of hash functions used by the filter.
+func (f *Filter) HashFunctions() uint32 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.k
+}
+
+// Clear clears all the items from the filter.
+func (f *Filter) Clear() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	clear(f.bits)
+	f.count = 0
+}
+
+// IsEmpty returns true if nothing has been added to the filter. Otherwise, false.
+func (f *Filter) IsEmpty() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.count == 0
+}
+
+// Len returns the number of items added to the filter. Adding the same item twice counts twice.
+func (f *Filter) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.count
+}
+
+// MarshalBinary encodes the filter into a binary form.
+func (f *Filter) MarshalBinary() ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	data := encodeHeader(filterKind, f.m, f.k, f.count, len(f.bits)*8)
+	for _, word := range f.bits {
+		data = binary.LittleEndian.AppendUint64(data, word)
+	}
+
+	return data, nil
+}
+
+// UnmarshalBinary decodes a filter produced by MarshalBinary, replacing the filter's contents.
+func (f *Filter) UnmarshalBinary(data []byte) error {
+	m, k, count, payload, err := decodeHeader(filterKind, data)
+	if err != nil {
+		return err
+	}
+
+	// Derive the word count from the payload rather than from m, so a corrupted m can't
+	// overflow the arithmetic; m must then fit the last word exactly.
+	if len(payload)%8 != 0 {
+		return fmt.Errorf("%w: %d bytes of bits isn't a whole number of words", ErrInvalidEncoding, len(payload))
+	}
+
+	words := uint64(len(payload) / 8)
+	if words == 0 || m <= (words-1)*wordSize || m > words*wordSize {
+		return fmt.Errorf("%w: %d bits don't fit in %d words", ErrInvalidEncoding, m, words)
+	}
+
+	newBits := make([]uint64, words)
+	for i := range newBits {
+		newBits[i] = binary.LittleEndian.Uint64(payload[i*8:])
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.bits = newBits
+	f.m = m
+	f.k = k
+	f.count = count
+
+	return nil
+}
+
+// optimalParameters calculates the number of bits and hash functions needed to hold n items
+// with a false-positive rate of p.
+func optimalParameters(n int, p float64) (uint64, uint32, error) {
+	if n <= 0 {
+		return 0, 0, fmt.Errorf("%w: %d", ErrInvalidCapacity, n)
+	}
+
+	if p <= 0 || p >= 1 || math.IsNaN(p) {
+		return 0, 0, fmt.Errorf("%w: %v", ErrInvalidFalsePositiveRate, p)
+	}
+
+	// m = -n * ln(p) / ln(2)^2
+	m := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	// k = m / n * ln(2)
+	k := uint32(math.Round(float64(m) / float64(n) * math.Ln2))
+
+	return max(m, 1), max(k, 1), nil
+}
+
+// hashes returns the two 64-bit hashes used to derive every hash function. The second hash is
+// forced to be odd so it never degenerates into a single location.
+func hashes(data []byte) (uint64, uint64) {
+	hasher := fnv.New128a()
+	_, _ = hasher.Write(data)
+	sum := hasher.Sum(nil)
+
+	return binary.BigEndian.Uint64(sum[:8]), binary.BigEndian.Uint64(sum[8:]) | 1
+}
+
+// location returns the position of the i-th hash function using double hashing
+// (Kirsch-Mitzenmacher): h1 + i*h2.
+func location(h1, h2 uint64, i uint32, m uint64) uint64 {
+	return (h1 + uint64(i)*h2) % m
+}
+
+// encodeHeader writes the common binary header and reserves room for the payload.
+func encodeHeader(kind byte, m uint64, k uint32, count int, payloadSize int) []byte {
+	data := make([]byte, 0, headerSize+payloadSize)
+	data = append(data, kind, encodingVersion)
+	data = binary.LittleEndian.AppendUint32(data, k)
+	data = binary.LittleEndian.AppendUint64(data, m)
+	data = binary.LittleEndian.AppendUint64(data, uint64(count))
+
+	return data
+}
+
+// decodeHeader validates the common binary header and returns its fields and the payload.
+func decodeHeader(kind byte, data []byte) (uint64, uint32, int, []byte, error) {
+	if len(data) < headerSize {
+		return 0, 0, 0, nil, fmt.Errorf("%w: %d bytes is too short", ErrInvalidEncoding, len(data))
+	}
+
+	if data[0] != kind || data[1] != encodingVersion {
+		return 0, 0, 0, nil, fmt.Errorf("%w: unexpected kind %q version %d", ErrInvalidEncoding, data[0], data[1])
+	}
+
+	k := binary.LittleEndian.Uint32(data[2:])
+	m := binary.LittleEndian.Uint64(data[6:])
+	count := binary.LittleEndian.Uint64(data[14:])
+
+	if m == 0 || k == 0 || k > maxHashFunctions || count > math.MaxInt {
+		return 0, 0, 0, nil, fmt.Errorf("%w: m=%d k=%d count=%d", ErrInvalidEncoding, m, k, count)
+	}
+
+	return m, k, int(count), data[headerSize:], nil
+}
@@ -0,0 +1,40 @@
+// Package bloom implements the Bloom filter and counting Bloom filter data structures.
+package bloom
+
+import "errors"
+
+var (
+	// ErrInvalidCapacity is an error indicating the expected number of items is not positive.
+	ErrInvalidCapacity = errors.New("invalid capacity")
+
+	// ErrInvalidFalsePositiveRate is an error indicating the false-positive rate is not
+	// strictly between 0 and 1.
+	ErrInvalidFalsePositiveRate = errors.New("invalid false-positive rate")
+
+	// ErrIncompatibleFilters is an error indicating two filters don't share the same
+	// number of bits and hash functions, so they can't be combined.
+	ErrIncompatibleFilters = errors.New("incompatible filters")
+
+	// ErrInvalidEncoding is an error indicating the binary data can't be decoded into a filter.
+	ErrInvalidEncoding = errors.New("invalid encoding")
+)
+
+// Filterer defines the operations shared by all the Bloom filters.
+type Filterer interface {
+	Add([]byte)
+	AddString(string)
+	MayContain([]byte) bool
+	MayContainString(string) bool
+	Clear()
+	IsEmpty() bool
+	Len() int
+	MarshalBinary() ([]byte, error)
+	UnmarshalBinary([]byte) error
+}
+
+// Remover defines the operations for Bloom filters that support removing items.
+type Remover interface {
+	Filterer
+	Remove([]byte) bool
+	RemoveString(string) bool
+}
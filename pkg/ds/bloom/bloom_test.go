@@ -0,0 +1,226 @@
+package bloom_test
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/bloom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type BloomFilterTestSuite struct {
+	suite.Suite
+	filter *bloom.Filter
+}
+
+func TestBloomFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(BloomFilterTestSuite))
+}
+
+func (suite *BloomFilterTestSuite) SetupTest() {
+	filter, err := bloom.New(1000, 0.01)
+	require.NoError(suite.T(), err)
+
+	suite.filter = filter
+}
+
+func (suite *BloomFilterTestSuite) TestNew() {
+	// 1000 items at 1% needs ~9586 bits and 7 hash functions.
+	expectedBits := uint64(9586)
+	expectedHashFunctions := uint32(7)
+
+	assert.NotNil(suite.T(), suite.filter)
+	assert.Equal(suite.T(), expectedBits, suite.filter.Bits())
+	assert.Equal(suite.T(), expectedHashFunctions, suite.filter.HashFunctions())
+	assert.True(suite.T(), suite.filter.IsEmpty())
+}
+
+func (suite *BloomFilterTestSuite) TestNewInvalidCapacity() {
+	filter, err := bloom.New(0, 0.01)
+
+	assert.Nil(suite.T(), filter)
+	assert.ErrorIs(suite.T(), err, bloom.ErrInvalidCapacity)
+}
+
+func (suite *BloomFilterTestSuite) TestNewInvalidFalsePositiveRate() {
+	for _, rate := range []float64{0, 1, -0.5, 1.5} {
+		filter, err := bloom.New(100, rate)
+
+		assert.Nil(suite.T(), filter)
+		assert.ErrorIs(suite.T(), err, bloom.ErrInvalidFalsePositiveRate)
+	}
+}
+
+func (suite *BloomFilterTestSuite) TestAddMayContain() {
+	suite.filter.AddString("hello")
+	suite.filter.Add([]byte("world"))
+
+	assert.True(suite.T(), suite.filter.MayContain([]byte("hello")))
+	assert.True(suite.T(), suite.filter.MayContainString("world"))
+	assert.False(suite.T(), suite.filter.MayContainString("cheese"))
+	assert.Equal(suite.T(), 2, suite.filter.Len())
+}
+
+func (suite *BloomFilterTestSuite) TestNoFalseNegatives() {
+	for i := range 1000 {
+		suite.filter.AddString(strconv.Itoa(i))
+	}
+
+	for i := range 1000 {
+		assert.True(suite.T(), suite.filter.MayContainString(strconv.Itoa(i)))
+	}
+}
+
+func (suite *BloomFilterTestSuite) TestFalsePositiveRate() {
+	expectedItems := 10000
+	targetRate := 0.01
+	probes := 100000
+
+	filter, err := bloom.New(expectedItems, targetRate)
+	require.NoError(suite.T(), err)
+
+	for i := range expectedItems {
+		filter.AddString("member-" + strconv.Itoa(i))
+	}
+
+	falsePositives := 0
+
+	for i := range probes {
+		if filter.MayContainString("stranger-" + strconv.Itoa(i)) {
+			falsePositives++
+		}
+	}
+
+	actualRate := float64(falsePositives) / float64(probes)
+
+	// Allow some slack for randomness, but the rate must stay near the target.
+	assert.Less(suite.T(), actualRate, targetRate*1.5)
+	assert.InDelta(suite.T(), targetRate, filter.EstimatedFalsePositiveRate(), targetRate*0.5)
+}
+
+func (suite *BloomFilterTestSuite) TestUnion() {
+	filterB, err := bloom.New(1000, 0.01)
+	require.NoError(suite.T(), err)
+
+	suite.filter.AddString("one")
+	suite.filter.AddString("two")
+	filterB.AddString("three")
+
+	union, err := suite.filter.Union(filterB)
+
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), union.MayContainString("one"))
+	assert.True(suite.T(), union.MayContainString("two"))
+	assert.True(suite.T(), union.MayContainString("three"))
+	assert.Equal(suite.T(), 3, union.Len())
+	// The original filters are left untouched.
+	assert.False(suite.T(), suite.filter.MayContainString("three"))
+}
+
+func (suite *BloomFilterTestSuite) TestUnionIncompatible() {
+	filterB, err := bloom.New(50, 0.1)
+	require.NoError(suite.T(), err)
+
+	union, err := suite.filter.Union(filterB)
+
+	assert.Nil(suite.T(), union)
+	assert.ErrorIs(suite.T(), err, bloom.ErrIncompatibleFilters)
+}
+
+func (suite *BloomFilterTestSuite) TestClear() {
+	suite.filter.AddString("hello")
+	suite.filter.Clear()
+
+	assert.True(suite.T(), suite.filter.IsEmpty())
+	assert.Equal(suite.T(), 0, suite.filter.Len())
+	assert.False(suite.T(), suite.filter.MayContainString("hello"))
+}
+
+func (suite *BloomFilterTestSuite) TestMarshalUnmarshal() {
+	for i := range 100 {
+		suite.filter.AddString(strconv.Itoa(i))
+	}
+
+	data, err := suite.filter.MarshalBinary()
+	require.NoError(suite.T(), err)
+
+	decoded := &bloom.Filter{}
+	require.NoError(suite.T(), decoded.UnmarshalBinary(data))
+
+	assert.Equal(suite.T(), suite.filter.Bits(), decoded.Bits())
+	assert.Equal(suite.T(), suite.filter.HashFunctions(), decoded.HashFunctions())
+	assert.Equal(suite.T(), suite.filter.Len(), decoded.Len())
+
+	for i := range 100 {
+		assert.True(suite.T(), decoded.MayContainString(strconv.Itoa(i)))
+	}
+}
+
+func (suite *BloomFilterTestSuite) TestUnmarshalInvalid() {
+	data, err := suite.filter.MarshalBinary()
+	require.NoError(suite.T(), err)
+
+	decoded := &bloom.Filter{}
+
+	assert.ErrorIs(suite.T(), decoded.UnmarshalBinary(data[:10]), bloom.ErrInvalidEncoding)
+	assert.ErrorIs(suite.T(), decoded.UnmarshalBinary(data[:len(data)-1]), bloom.ErrInvalidEncoding)
+
+	counting, err := bloom.NewCounting(1000, 0.01)
+	require.NoError(suite.T(), err)
+
+	countingData, err := counting.MarshalBinary()
+	require.NoError(suite.T(), err)
+
+	assert.ErrorIs(suite.T(), decoded.UnmarshalBinary(countingData), bloom.ErrInvalidEncoding)
+}
+
+func (suite *BloomFilterTestSuite) TestUnmarshalCorruptedHeader() {
+	suite.filter.AddString("kept")
+
+	data, err := suite.filter.MarshalBinary()
+	require.NoError(suite.T(), err)
+
+	words := (suite.filter.Bits() + 63) / 64
+	payloadStart := len(data) - int(words)*8
+
+	// withM returns the encoding with m, stored after the kind, version and k, replaced.
+	withM := func(data []byte, m uint64) []byte {
+		corrupted := append([]byte{}, data...)
+		binary.LittleEndian.PutUint64(corrupted[6:], m)
+
+		return corrupted
+	}
+
+	// withK returns the encoding with k, stored after the kind and version, replaced.
+	withK := func(data []byte, k uint32) []byte {
+		corrupted := append([]byte{}, data...)
+		binary.LittleEndian.PutUint32(corrupted[2:], k)
+
+		return corrupted
+	}
+
+	corruptions := map[string][]byte{
+		"huge k":                withK(data, math.MaxUint32),
+		"k just past the limit": withK(data, 1<<11+1),
+		"huge m without bits":   withM(data[:payloadStart], math.MaxUint64),
+		"huge m with bits":      withM(data, math.MaxUint64),
+		"m whose words*8 wraps": withM(data, 1<<61+1),
+		"m one word too large":  withM(data, words*64+1),
+		"m one word too small":  withM(data, (words-1)*64),
+		"bits not a whole word": append(withM(data, suite.filter.Bits()), 0),
+	}
+
+	for name, corrupted := range corruptions {
+		decoded := &bloom.Filter{}
+		assert.ErrorIs(suite.T(), decoded.UnmarshalBinary(corrupted), bloom.ErrInvalidEncoding, name)
+
+		// A failed decode leaves the filter untouched.
+		require.NoError(suite.T(), suite.filter.UnmarshalBinary(data))
+		assert.ErrorIs(suite.T(), suite.filter.UnmarshalBinary(corrupted), bloom.ErrInvalidEncoding, name)
+		assert.True(suite.T(), suite.filter.MayContainString("kept"), name)
+	}
+}
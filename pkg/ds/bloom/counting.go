@@ -0,0 +1,223 @@
+// Package bloom implements the Bloom filter and counting Bloom filter data structures.
+package bloom
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// CountingFilter represents a counting Bloom filter. Instead of a single bit, every position
+// holds a small counter, which allows items to be removed again.
+// Mutex ensures the implementation of CountingFilter is thread-safe.
+type CountingFilter struct {
+	// counters saturate at math.MaxUint8. A saturated counter is never decremented, since
+	// its real value is unknown, so removals can't introduce false negatives.
+	counters []uint8
+	m        uint64 // The number of counters in the filter.
+	k        uint32 // The number of hash functions.
+	count    int    // The number of items currently in the filter.
+	mu       sync.Mutex
+}
+
+// NewCounting creates a new counting Bloom filter sized to hold expectedItems items while
+// keeping the false-positive rate at or below falsePositiveRate.
+func NewCounting(expectedItems int, falsePositiveRate float64) (*CountingFilter, error) {
+	m, k, err := optimalParameters(expectedItems, falsePositiveRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCountingFilter(m, k), nil
+}
+
+// newCountingFilter creates a new counting Bloom filter with m counters and k hash functions.
+func newCountingFilter(m uint64, k uint32) *CountingFilter {
+	return &CountingFilter{
+		counters: make([]uint8, m),
+		m:        m,
+		k:        k,
+	}
+}
+
+// Add adds an item to the filter.
+func (f *CountingFilter) Add(data []byte) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	h1, h2 := hashes(data)
+	for i := range f.k {
+		position := location(h1, h2, i, f.m)
+		if f.counters[position] < math.MaxUint8 {
+			f.counters[position]++
+		}
+	}
+
+	f.count++
+}
+
+// AddString adds a string item to the filter.
+func (f *CountingFilter) AddString(data string) {
+	f.Add([]byte(data))
+}
+
+// Remove removes an item from the filter. It returns false, leaving the filter untouched,
+// if the item is definitely not in the filter. Removing an item that was never added, but
+// is reported as a false positive, corrupts the filter for other items.
+func (f *CountingFilter) Remove(data []byte) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	h1, h2 := hashes(data)
+	if !f.mayContain(h1, h2) {
+		return false
+	}
+
+	for i := range f.k {
+		position := location(h1, h2, i, f.m)
+		if f.counters[position] < math.MaxUint8 {
+			f.counters[position]--
+		}
+	}
+
+	// A removed false positive or an item stuck at saturated counters can take more removals
+	// than there were adds; keep count from going negative so the filter still encodes.
+	if f.count > 0 {
+		f.count--
+	}
+
+	return true
+}
+
+// RemoveString removes a string item from the filter.
+func (f *CountingFilter) RemoveString(data string) bool {
+	return f.Remove([]byte(data))
+}
+
+// MayContain returns true if the item may be in the filter. A false result means the item
+// is definitely not in the filter.
+func (f *CountingFilter) MayContain(data []byte) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	h1, h2 := hashes(data)
+
+	return f.mayContain(h1, h2)
+}
+
+// MayContainString returns true if the string item may be in the filter.
+func (f *CountingFilter) MayContainString(data string) bool {
+	return f.MayContain([]byte(data))
+}
+
+// mayContain checks every counter for the hashes. The caller must hold the lock.
+func (f *CountingFilter) mayContain(h1, h2 uint64) bool {
+	for i := range f.k {
+		if f.counters[location(h1, h2, i, f.m)] == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Union returns a new filter containing the items from this filter and the provided filter.
+// Both filters must have been created with the same number of counters and hash functions.
+func (f *CountingFilter) Union(filterB *CountingFilter) (*CountingFilter, error) {
+	// Copy filterB first so both locks are never held at the same time.
+	filterB.mu.Lock()
+	otherCounters := append([]uint8(nil), filterB.counters...)
+	otherM, otherK, otherCount := filterB.m, filterB.k, filterB.count
+	filterB.mu.Unlock()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.m != otherM || f.k != otherK {
+		return nil, fmt.Errorf("%w: m=%d k=%d and m=%d k=%d", ErrIncompatibleFilters, f.m, f.k, otherM, otherK)
+	}
+
+	newFilter := newCountingFilter(f.m, f.k)
+	for i := range f.counters {
+		// Add the counters, saturating instead of overflowing.
+		newFilter.counters[i] = uint8(min(int(f.counters[i])+int(otherCounters[i]), math.MaxUint8))
+	}
+
+	newFilter.count = f.count + otherCount
+
+	return newFilter, nil
+}
+
+// Filter returns a plain Bloom filter holding the same items.
+func (f *CountingFilter) Filter() *Filter {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	filter := newFilter(f.m, f.k)
+	for i, counter := range f.counters {
+		if counter > 0 {
+			filter.bits[i/wordSize] |= 1 << (uint(i) % wordSize)
+		}
+	}
+
+	filter.count = f.count
+
+	return filter
+}
+
+// Clear clears all the items from the filter.
+func (f *CountingFilter) Clear() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	clear(f.counters)
+	f.count = 0
+}
+
+// IsEmpty returns true if the filter holds no items. Otherwise, false.
+func (f *CountingFilter) IsEmpty() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.count == 0
+}
+
+// Len returns the number of items in the filter.
+func (f *CountingFilter) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.count
+}
+
+// MarshalBinary encodes the filter into a binary form.
+func (f *CountingFilter) MarshalBinary() ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	data := encodeHeader(countingKind, f.m, f.k, f.count, len(f.counters))
+
+	return append(data, f.counters...), nil
+}
+
+// UnmarshalBinary decodes a filter produced by MarshalBinary, replacing the filter's contents.
+func (f *CountingFilter) UnmarshalBinary(data []byte) error {
+	m, k, count, payload, err := decodeHeader(countingKind, data)
+	if err != nil {
+		return err
+	}
+
+	if uint64(len(payload)) != m {
+		return fmt.Errorf("%w: expected %d bytes of counters, got %d", ErrInvalidEncoding, m, len(payload))
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.counters = append([]uint8(nil), payload...)
+	f.m = m
+	f.k = k
+	f.count = count
+
+	return nil
+}
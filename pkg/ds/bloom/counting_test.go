@@ -0,0 +1,199 @@
+package bloom_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/bloom"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CountingFilterTestSuite struct {
+	suite.Suite
+	filter *bloom.CountingFilter
+}
+
+func TestCountingFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(CountingFilterTestSuite))
+}
+
+func (suite *CountingFilterTestSuite) SetupTest() {
+	filter, err := bloom.NewCounting(1000, 0.01)
+	require.NoError(suite.T(), err)
+
+	suite.filter = filter
+}
+
+func (suite *CountingFilterTestSuite) TestNewInvalid() {
+	filter, err := bloom.NewCounting(-1, 0.01)
+
+	assert.Nil(suite.T(), filter)
+	assert.ErrorIs(suite.T(), err, bloom.ErrInvalidCapacity)
+}
+
+func (suite *CountingFilterTestSuite) TestAddRemove() {
+	suite.filter.AddString("hello")
+	suite.filter.AddString("world")
+
+	assert.True(suite.T(), suite.filter.MayContainString("hello"))
+	assert.Equal(suite.T(), 2, suite.filter.Len())
+
+	removed := suite.filter.RemoveString("hello")
+
+	assert.True(suite.T(), removed)
+	assert.False(suite.T(), suite.filter.MayContainString("hello"))
+	assert.True(suite.T(), suite.filter.MayContain([]byte("world")))
+	assert.Equal(suite.T(), 1, suite.filter.Len())
+}
+
+func (suite *CountingFilterTestSuite) TestRemoveMissing() {
+	suite.filter.AddString("hello")
+
+	removed := suite.filter.RemoveString("cheese")
+
+	assert.False(suite.T(), removed)
+	assert.True(suite.T(), suite.filter.MayContainString("hello"))
+	assert.Equal(suite.T(), 1, suite.filter.Len())
+}
+
+func (suite *CountingFilterTestSuite) TestExtraRemovalsKeepLenAtZero() {
+	// Saturated counters never decrement, so the item can be removed more often than added.
+	for range 300 {
+		suite.filter.AddString("hello")
+	}
+
+	for range 301 {
+		assert.True(suite.T(), suite.filter.RemoveString("hello"))
+	}
+
+	assert.Equal(suite.T(), 0, suite.filter.Len())
+
+	data, err := suite.filter.MarshalBinary()
+	require.NoError(suite.T(), err)
+
+	decoded := &bloom.CountingFilter{}
+	require.NoError(suite.T(), decoded.UnmarshalBinary(data))
+	assert.Equal(suite.T(), 0, decoded.Len())
+}
+
+func (suite *CountingFilterTestSuite) TestDuplicateAdds() {
+	suite.filter.AddString("hello")
+	suite.filter.AddString("hello")
+
+	assert.True(suite.T(), suite.filter.RemoveString("hello"))
+	assert.True(suite.T(), suite.filter.MayContainString("hello"))
+	assert.True(suite.T(), suite.filter.RemoveString("hello"))
+	assert.False(suite.T(), suite.filter.MayContainString("hello"))
+	assert.True(suite.T(), suite.filter.IsEmpty())
+}
+
+func (suite *CountingFilterTestSuite) TestSaturatedCountersNeverDecrement() {
+	for range 300 {
+		suite.filter.AddString("hot")
+	}
+
+	for range 300 {
+		suite.filter.RemoveString("hot")
+	}
+
+	// The counters saturated, so the item sticks around rather than risking false negatives.
+	assert.True(suite.T(), suite.filter.MayContainString("hot"))
+}
+
+func (suite *CountingFilterTestSuite) TestFalsePositiveRateAfterRemovals() {
+	expectedItems := 10000
+	targetRate := 0.01
+	probes := 100000
+
+	filter, err := bloom.NewCounting(expectedItems, targetRate)
+	require.NoError(suite.T(), err)
+
+	// Add twice as many items as expected and remove half of them again.
+	for i := range expectedItems * 2 {
+		filter.AddString("member-" + strconv.Itoa(i))
+	}
+
+	for i := expectedItems; i < expectedItems*2; i++ {
+		filter.RemoveString("member-" + strconv.Itoa(i))
+	}
+
+	for i := range expectedItems {
+		assert.True(suite.T(), filter.MayContainString("member-"+strconv.Itoa(i)))
+	}
+
+	falsePositives := 0
+
+	for i := range probes {
+		if filter.MayContainString("stranger-" + strconv.Itoa(i)) {
+			falsePositives++
+		}
+	}
+
+	assert.Less(suite.T(), float64(falsePositives)/float64(probes), targetRate*1.5)
+}
+
+func (suite *CountingFilterTestSuite) TestUnion() {
+	filterB, err := bloom.NewCounting(1000, 0.01)
+	require.NoError(suite.T(), err)
+
+	suite.filter.AddString("one")
+	filterB.AddString("two")
+
+	union, err := suite.filter.Union(filterB)
+	require.NoError(suite.T(), err)
+
+	assert.True(suite.T(), union.MayContainString("one"))
+	assert.True(suite.T(), union.MayContainString("two"))
+	assert.True(suite.T(), union.RemoveString("one"))
+	assert.False(suite.T(), union.MayContainString("one"))
+	assert.Equal(suite.T(), 1, union.Len())
+}
+
+func (suite *CountingFilterTestSuite) TestUnionIncompatible() {
+	filterB, err := bloom.NewCounting(10, 0.2)
+	require.NoError(suite.T(), err)
+
+	union, err := suite.filter.Union(filterB)
+
+	assert.Nil(suite.T(), union)
+	assert.ErrorIs(suite.T(), err, bloom.ErrIncompatibleFilters)
+}
+
+func (suite *CountingFilterTestSuite) TestFilter() {
+	suite.filter.AddString("one")
+	suite.filter.AddString("two")
+
+	filter := suite.filter.Filter()
+
+	assert.True(suite.T(), filter.MayContainString("one"))
+	assert.True(suite.T(), filter.MayContainString("two"))
+	assert.Equal(suite.T(), 2, filter.Len())
+}
+
+func (suite *CountingFilterTestSuite) TestClear() {
+	suite.filter.AddString("one")
+	suite.filter.Clear()
+
+	assert.True(suite.T(), suite.filter.IsEmpty())
+	assert.False(suite.T(), suite.filter.MayContainString("one"))
+}
+
+func (suite *CountingFilterTestSuite) TestMarshalUnmarshal() {
+	suite.filter.AddString("one")
+	suite.filter.AddString("two")
+
+	data, err := suite.filter.MarshalBinary()
+	require.NoError(suite.T(), err)
+
+	decoded := &bloom.CountingFilter{}
+	require.NoError(suite.T(), decoded.UnmarshalBinary(data))
+
+	assert.Equal(suite.T(), 2, decoded.Len())
+	assert.True(suite.T(), decoded.RemoveString("one"))
+	assert.False(suite.T(), decoded.MayContainString("one"))
+	assert.True(suite.T(), decoded.MayContainString("two"))
+
+	assert.ErrorIs(suite.T(), decoded.UnmarshalBinary(data[:len(data)-3]), bloom.ErrInvalidEncoding)
+}
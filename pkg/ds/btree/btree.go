@@ -0,0 +1,410 @@
+// Package btree implements the B-tree data structure.
+package btree
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/dqfan2012/playground/pkg/ds/hashmap"
+)
+
+// DefaultDegree is the minimum degree New uses. Nodes hold between 31 and 63 keys, which
+// keeps them a few cache lines wide for small keys.
+const DefaultDegree = 32
+
+// BTree satisfies the ordered map interface, and hashmap.Mapper when its keys are strings.
+var (
+	_ OrderedMapper[int, int] = (*BTree[int, int])(nil)
+	_ hashmap.Mapper[int]     = (*BTree[string, int])(nil)
+)
+
+// BTree represents a B-tree: a balanced search tree whose nodes each hold many keys in a
+// sorted slice, so a search touches only O(log n / log degree) nodes and scans memory that
+// sits together. Every node except the root holds between degree-1 and 2*degree-1 keys, and
+// every leaf is at the same depth.
+//
+// Nodes are copied on write. Clone and the iterators share the tree's nodes instead of
+// copying them, and the next writes copy only the nodes on their paths.
+// Mutex ensures the implementation of BTree is thread-safe.
+type BTree[K cmp.Ordered, V any] struct {
+	root   *node[K, V]
+	degree int
+	len    int
+	// owner marks the nodes this tree may modify in place. Nodes with any other owner are
+	// shared with a clone or an iterator and are copied before they change.
+	owner *owner
+	mu    sync.Mutex
+}
+
+// owner identifies the nodes a tree may modify. It isn't empty because pointers to distinct
+// zero-size values may compare equal.
+type owner struct {
+	_ byte
+}
+
+// node holds sorted items and, unless it is a leaf, one more child than items. children[i]
+// holds the keys between items[i-1] and items[i].
+type node[K cmp.Ordered, V any] struct {
+	items    []item[K, V]
+	children []*node[K, V]
+	owner    *owner
+}
+
+// item is a key and its value.
+type item[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+}
+
+// New creates a new B-tree with the default minimum degree.
+func New[K cmp.Ordered, V any]() *BTree[K, V] {
+	return &BTree[K, V]{degree: DefaultDegree, owner: &owner{}}
+}
+
+// NewWithDegree creates a new B-tree with the given minimum degree, which must be at least 2.
+// A larger degree makes the tree shallower and its nodes wider.
+func NewWithDegree[K cmp.Ordered, V any](degree int) (*BTree[K, V], error) {
+	if degree < 2 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidDegree, degree)
+	}
+
+	return &BTree[K, V]{degree: degree, owner: &owner{}}, nil
+}
+
+// Insert sets the value for the key.
+func (t *BTree[K, V]) Insert(key K, value V) {
+	t.Put(key, value)
+}
+
+// Put sets the value for the key. It returns the value it replaced and true, or the zero
+// value and false if the key is new.
+func (t *BTree[K, V]) Put(key K, value V) (V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.root == nil {
+		t.root = t.newNode()
+	} else {
+		t.root = t.mutable(t.root)
+	}
+
+	// Splitting a full root is the only way the tree grows taller.
+	if len(t.root.items) == t.maxItems() {
+		middle, right := t.split(t.root, t.degree-1)
+		t.root = &node[K, V]{
+			items:    []item[K, V]{middle},
+			children: []*node[K, V]{t.root, right},
+			owner:    t.owner,
+		}
+	}
+
+	old, replaced := t.insert(t.root, item[K, V]{key: key, value: value})
+	if !replaced {
+		t.len++
+	}
+
+	return old, replaced
+}
+
+// Get returns a pointer to a copy of the value for the key and true, or nil and false if the
+// key isn't present. Use Put to change the value.
+func (t *BTree[K, V]) Get(key K) (*V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for current := t.root; current != nil; {
+		i, found := current.find(key)
+		if found {
+			value := current.items[i].value
+
+			return &value, true
+		}
+
+		if current.isLeaf() {
+			break
+		}
+
+		current = current.children[i]
+	}
+
+	return nil, false
+}
+
+// Delete removes the key if it is present.
+func (t *BTree[K, V]) Delete(key K) {
+	t.Remove(key)
+}
+
+// Remove removes the key. It returns the removed value and true, or the zero value and false
+// if the key isn't present.
+func (t *BTree[K, V]) Remove(key K) (V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.root == nil {
+		var zero V
+
+		return zero, false
+	}
+
+	t.root = t.mutable(t.root)
+	removed, found := t.remove(t.root, key)
+
+	// Merging the root's last two children empties it, which is the only way the tree
+	// grows shorter.
+	if len(t.root.items) == 0 {
+		if t.root.isLeaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+
+	if found {
+		t.len--
+	}
+
+	return removed.value, found
+}
+
+// Clone returns a copy of the tree in O(1). The copies share their nodes until either of
+// them writes to one.
+func (t *BTree[K, V]) Clone() *BTree[K, V] {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// Both trees need a new owner, since either may still hold nodes owned by the old one.
+	t.owner = &owner{}
+
+	return &BTree[K, V]{root: t.root, degree: t.degree, len: t.len, owner: &owner{}}
+}
+
+// Clear removes every key.
+func (t *BTree[K, V]) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.root = nil
+	t.len = 0
+}
+
+// IsEmpty returns true if the tree holds no keys.
+func (t *BTree[K, V]) IsEmpty() bool {
+	return t.Len() == 0
+}
+
+// Len returns the number of keys.
+func (t *BTree[K, V]) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.len
+}
+
+// insert sets the item in the subtree rooted at a mutable node that isn't full. It returns
+// the value it replaced and true, or false if the key is new.
+func (t *BTree[K, V]) insert(n *node[K, V], it item[K, V]) (V, bool) {
+	i, found := n.find(it.key)
+	if found {
+		old := n.items[i].value
+		n.items[i] = it
+
+		return old, true
+	}
+
+	if n.isLeaf() {
+		n.items = slices.Insert(n.items, i, it)
+
+		var zero V
+
+		return zero, false
+	}
+
+	// Splitting full children on the way down leaves room for the key wherever it lands.
+	child := t.mutableChild(n, i)
+	if len(child.items) == t.maxItems() {
+		middle, right := t.split(child, t.degree-1)
+		n.items = slices.Insert(n.items, i, middle)
+		n.children = slices.Insert(n.children, i+1, right)
+
+		switch order := cmp.Compare(it.key, middle.key); {
+		case order == 0:
+			old := n.items[i].value
+			n.items[i] = it
+
+			return old, true
+		case order > 0:
+			i++
+		}
+	}
+
+	return t.insert(n.children[i], it)
+}
+
+// remove removes the key from the subtree rooted at a mutable node. Every node it descends
+// into is first grown to at least degree items, so removing one never leaves it too small.
+func (t *BTree[K, V]) remove(n *node[K, V], key K) (item[K, V], bool) {
+	i, found := n.find(key)
+
+	if n.isLeaf() {
+		if !found {
+			return item[K, V]{}, false
+		}
+
+		removed := n.items[i]
+		n.items = slices.Delete(n.items, i, i+1)
+
+		return removed, true
+	}
+
+	if len(n.children[i].items) <= t.minItems() {
+		t.growChild(n, i)
+
+		// Growing moves items between n and its children, so look the key up again.
+		return t.remove(n, key)
+	}
+
+	child := t.mutableChild(n, i)
+
+	if found {
+		// Replace the key with its predecessor, the largest key of the left child.
+		removed := n.items[i]
+		n.items[i] = t.removeMax(child)
+
+		return removed, true
+	}
+
+	return t.remove(child, key)
+}
+
+// removeMax removes and returns the largest item of the subtree rooted at a mutable node.
+func (t *BTree[K, V]) removeMax(n *node[K, V]) item[K, V] {
+	if n.isLeaf() {
+		last := n.items[len(n.items)-1]
+		n.items = n.items[:len(n.items)-1]
+
+		return last
+	}
+
+	if len(n.children[len(n.items)].items) <= t.minItems() {
+		t.growChild(n, len(n.items))
+	}
+
+	return t.removeMax(t.mutableChild(n, len(n.items)))
+}
+
+// growChild gives the mutable node's child i at least degree items by borrowing one through
+// the node from a sibling with items to spare, or by merging it with a sibling.
+func (t *BTree[K, V]) growChild(n *node[K, V], i int) {
+	switch {
+	case i > 0 && len(n.children[i-1].items) > t.minItems():
+		child, left := t.mutableChild(n, i), t.mutableChild(n, i-1)
+
+		child.items = slices.Insert(child.items, 0, n.items[i-1])
+		n.items[i-1] = left.items[len(left.items)-1]
+		left.items = left.items[:len(left.items)-1]
+
+		if !left.isLeaf() {
+			child.children = slices.Insert(child.children, 0, left.children[len(left.children)-1])
+			left.children = left.children[:len(left.children)-1]
+		}
+	case i < len(n.items) && len(n.children[i+1].items) > t.minItems():
+		child, right := t.mutableChild(n, i), t.mutableChild(n, i+1)
+
+		child.items = append(child.items, n.items[i])
+		n.items[i] = right.items[0]
+		right.items = slices.Delete(right.items, 0, 1)
+
+		if !right.isLeaf() {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+	default:
+		// Merge the child with its right sibling, or with its left one if it is the last.
+		if i == len(n.items) {
+			i--
+		}
+
+		child, sibling := t.mutableChild(n, i), n.children[i+1]
+
+		child.items = append(child.items, n.items[i])
+		child.items = append(child.items, sibling.items...)
+		child.children = append(child.children, sibling.children...)
+		n.items = slices.Delete(n.items, i, i+1)
+		n.children = slices.Delete(n.children, i+1, i+2)
+	}
+}
+
+// split moves the items after index i of a mutable node, and their children, into a new node.
+// It returns the item at index i, which is removed too, and the new node.
+func (t *BTree[K, V]) split(n *node[K, V], i int) (item[K, V], *node[K, V]) {
+	middle := n.items[i]
+	right := t.newNode()
+	right.items = append(right.items, n.items[i+1:]...)
+
+	clear(n.items[i:])
+	n.items = n.items[:i]
+
+	if !n.isLeaf() {
+		right.children = append(right.children, n.children[i+1:]...)
+
+		clear(n.children[i+1:])
+		n.children = n.children[:i+1]
+	}
+
+	return middle, right
+}
+
+// mutableChild makes the mutable node's child i safe to modify and returns it.
+func (t *BTree[K, V]) mutableChild(n *node[K, V], i int) *node[K, V] {
+	n.children[i] = t.mutable(n.children[i])
+
+	return n.children[i]
+}
+
+// mutable returns the node if the tree owns it, or a copy the tree owns if the node is shared.
+func (t *BTree[K, V]) mutable(n *node[K, V]) *node[K, V] {
+	if n.owner == t.owner {
+		return n
+	}
+
+	copied := t.newNode()
+	copied.items = append(copied.items, n.items...)
+
+	if !n.isLeaf() {
+		copied.children = make([]*node[K, V], len(n.children), t.maxItems()+1)
+		copy(copied.children, n.children)
+	}
+
+	return copied
+}
+
+// newNode creates an empty node owned by the tree, with room for a full node's items.
+func (t *BTree[K, V]) newNode() *node[K, V] {
+	return &node[K, V]{items: make([]item[K, V], 0, t.maxItems()), owner: t.owner}
+}
+
+// maxItems returns the most items a node may hold.
+func (t *BTree[K, V]) maxItems() int {
+	return 2*t.degree - 1
+}
+
+// minItems returns the fewest items a node other than the root may hold.
+func (t *BTree[K, V]) minItems() int {
+	return t.degree - 1
+}
+
+// find returns the index of the first item whose key isn't less than the key, and whether
+// that item's key is the key.
+func (n *node[K, V]) find(key K) (int, bool) {
+	return slices.BinarySearchFunc(n.items, key, func(it item[K, V], key K) int {
+		return cmp.Compare(it.key, key)
+	})
+}
+
+// isLeaf returns true if the node has no children.
+func (n *node[K, V]) isLeaf() bool {
+	return len(n.children) == 0
+}
@@ -0,0 +1,46 @@
+// Package btree implements the B-tree data structure.
+package btree
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+)
+
+// ErrInvalidDegree is an error indicating a minimum degree below 2.
+var ErrInvalidDegree = errors.New("invalid degree")
+
+// Mapper defines the operations that read and change single entries of a map.
+type Mapper[K cmp.Ordered, V any] interface {
+	Insert(key K, value V)
+	Delete(key K)
+	Get(key K) (*V, bool)
+	Put(key K, value V) (V, bool)
+	Remove(key K) (V, bool)
+}
+
+// Ranger defines the iteration over a sorted map, in either direction and over part of it.
+type Ranger[K cmp.Ordered, V any] interface {
+	All() iter.Seq2[K, V]
+	Ascend() iter.Seq2[K, V]
+	AscendFrom(pivot K) iter.Seq2[K, V]
+	AscendRange(from, to K) iter.Seq2[K, V]
+	Descend() iter.Seq2[K, V]
+	DescendFrom(pivot K) iter.Seq2[K, V]
+	DescendRange(from, to K) iter.Seq2[K, V]
+}
+
+// MapHelper defines additional operations for a map.
+type MapHelper interface {
+	Clear()
+	IsEmpty() bool
+	Len() int
+}
+
+// OrderedMapper combines the Mapper, Ranger and MapHelper operations for a map that keeps its
+// keys sorted.
+type OrderedMapper[K cmp.Ordered, V any] interface {
+	Mapper[K, V]
+	Ranger[K, V]
+	MapHelper
+}
@@ -0,0 +1,289 @@
+package btree_test
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/btree"
+	"github.com/dqfan2012/playground/pkg/ds/hashmap"
+	"github.com/dqfan2012/playground/pkg/ds/tree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type BTreeTestSuite struct {
+	suite.Suite
+	tree *btree.BTree[int, string]
+}
+
+func TestBTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(BTreeTestSuite))
+}
+
+func (suite *BTreeTestSuite) SetupTest() {
+	// The smallest degree splits and merges nodes the most often.
+	tree, err := btree.NewWithDegree[int, string](2)
+	require.NoError(suite.T(), err)
+
+	suite.tree = tree
+}
+
+func (suite *BTreeTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.tree.CheckInvariants())
+}
+
+// fill puts the keys, each with its name as the value.
+func (suite *BTreeTestSuite) fill(keys ...int) {
+	for _, key := range keys {
+		suite.tree.Put(key, name(key))
+	}
+}
+
+// name returns a value for the key.
+func name(key int) string {
+	return strconv.Itoa(key)
+}
+
+// keys collects the keys produced by an iterator.
+func keys(seq func(yield func(int, string) bool)) []int {
+	var collected []int
+
+	for key := range seq {
+		collected = append(collected, key)
+	}
+
+	return collected
+}
+
+func (suite *BTreeTestSuite) TestNew() {
+	tree := btree.New[string, int]()
+
+	assert.True(suite.T(), tree.IsEmpty())
+	assert.Equal(suite.T(), 0, tree.Len())
+	assert.NoError(suite.T(), tree.CheckInvariants())
+}
+
+func (suite *BTreeTestSuite) TestInvalidDegree() {
+	for _, degree := range []int{-1, 0, 1} {
+		tree, err := btree.NewWithDegree[int, int](degree)
+
+		assert.Nil(suite.T(), tree)
+		assert.ErrorIs(suite.T(), err, btree.ErrInvalidDegree)
+	}
+}
+
+func (suite *BTreeTestSuite) TestPutAndGet() {
+	_, replaced := suite.tree.Put(2, "two")
+	assert.False(suite.T(), replaced)
+
+	old, replaced := suite.tree.Put(2, "deux")
+	assert.True(suite.T(), replaced)
+	assert.Equal(suite.T(), "two", old)
+
+	value, found := suite.tree.Get(2)
+	require.True(suite.T(), found)
+	assert.Equal(suite.T(), "deux", *value)
+
+	value, found = suite.tree.Get(3)
+	assert.False(suite.T(), found)
+	assert.Nil(suite.T(), value)
+	assert.Equal(suite.T(), 1, suite.tree.Len())
+}
+
+func (suite *BTreeTestSuite) TestGetReturnsCopy() {
+	suite.fill(1)
+
+	value, _ := suite.tree.Get(1)
+	*value = "changed"
+
+	value, _ = suite.tree.Get(1)
+	assert.Equal(suite.T(), name(1), *value)
+}
+
+func (suite *BTreeTestSuite) TestRemove() {
+	suite.fill(1, 2, 3, 4, 5, 6, 7, 8, 9)
+
+	value, found := suite.tree.Remove(5)
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), name(5), value)
+
+	_, found = suite.tree.Remove(5)
+	assert.False(suite.T(), found)
+
+	suite.tree.Delete(1)
+	suite.tree.Delete(10)
+
+	assert.Equal(suite.T(), []int{2, 3, 4, 6, 7, 8, 9}, keys(suite.tree.All()))
+	assert.Equal(suite.T(), 7, suite.tree.Len())
+}
+
+func (suite *BTreeTestSuite) TestRemoveEverything() {
+	suite.fill(5, 3, 8, 1, 4, 7, 9, 2, 6)
+
+	for key := 1; key <= 9; key++ {
+		_, found := suite.tree.Remove(key)
+		require.True(suite.T(), found)
+		require.NoError(suite.T(), suite.tree.CheckInvariants())
+	}
+
+	assert.True(suite.T(), suite.tree.IsEmpty())
+
+	_, found := suite.tree.Remove(1)
+	assert.False(suite.T(), found)
+}
+
+func (suite *BTreeTestSuite) TestMapper() {
+	var mapper hashmap.Mapper[int] = btree.New[string, int]()
+
+	mapper.Insert("one", 1)
+	mapper.Insert("two", 2)
+	mapper.Delete("one")
+
+	value, found := mapper.Get("two")
+	require.True(suite.T(), found)
+	assert.Equal(suite.T(), 2, *value)
+
+	_, found = mapper.Get("one")
+	assert.False(suite.T(), found)
+}
+
+func (suite *BTreeTestSuite) TestClear() {
+	suite.fill(1, 2, 3)
+
+	suite.tree.Clear()
+
+	assert.True(suite.T(), suite.tree.IsEmpty())
+	assert.Empty(suite.T(), keys(suite.tree.All()))
+}
+
+func (suite *BTreeTestSuite) TestAgainstModel() {
+	for _, degree := range []int{2, 3, 5} {
+		tree, err := btree.NewWithDegree[int, string](degree)
+		require.NoError(suite.T(), err)
+
+		rng := rand.New(rand.NewPCG(uint64(degree), 7))
+		model := map[int]string{}
+
+		for step := range 5000 {
+			key := rng.IntN(300)
+
+			if rng.IntN(3) == 0 {
+				expected, existed := model[key]
+				delete(model, key)
+
+				value, found := tree.Remove(key)
+				require.Equal(suite.T(), existed, found)
+				require.Equal(suite.T(), expected, value)
+			} else {
+				value := name(rng.IntN(1000))
+				expected, existed := model[key]
+				model[key] = value
+
+				old, replaced := tree.Put(key, value)
+				require.Equal(suite.T(), existed, replaced)
+				require.Equal(suite.T(), expected, old)
+			}
+
+			require.NoError(suite.T(), tree.CheckInvariants(), "degree %d step %d", degree, step)
+		}
+
+		assert.Equal(suite.T(), slices.Sorted(maps.Keys(model)), keys(tree.All()))
+		assert.Equal(suite.T(), len(model), tree.Len())
+
+		for key, expected := range model {
+			value, found := tree.Get(key)
+			require.True(suite.T(), found)
+			assert.Equal(suite.T(), expected, *value)
+		}
+	}
+}
+
+func (suite *BTreeTestSuite) TestConcurrentPuts() {
+	var wg sync.WaitGroup
+
+	for worker := range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range 100 {
+				key := worker*100 + i
+				suite.tree.Put(key, name(key))
+				suite.tree.Get(key)
+
+				if i%2 == 0 {
+					suite.tree.Delete(key)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(suite.T(), 400, suite.tree.Len())
+}
+
+// benchmarkKeys returns n keys in a random order.
+func benchmarkKeys(n int) []int {
+	return rand.New(rand.NewPCG(1, 2)).Perm(n)
+}
+
+func BenchmarkGet(b *testing.B) {
+	const n = 1 << 16
+
+	shuffled := benchmarkKeys(n)
+
+	b.Run("BTree", func(b *testing.B) {
+		tree := btree.New[int, int]()
+		for _, key := range shuffled {
+			tree.Put(key, key)
+		}
+
+		b.ResetTimer()
+
+		for i := range b.N {
+			tree.Get(shuffled[i%n])
+		}
+	})
+
+	b.Run("AVL", func(b *testing.B) {
+		avl := tree.New[int, int]()
+		for _, key := range shuffled {
+			avl.Insert(key, key)
+		}
+
+		b.ResetTimer()
+
+		for i := range b.N {
+			avl.Get(shuffled[i%n])
+		}
+	})
+}
+
+func BenchmarkPut(b *testing.B) {
+	const n = 1 << 16
+
+	shuffled := benchmarkKeys(n)
+
+	b.Run("BTree", func(b *testing.B) {
+		tree := btree.New[int, int]()
+
+		for i := range b.N {
+			tree.Put(shuffled[i%n], i)
+		}
+	})
+
+	b.Run("AVL", func(b *testing.B) {
+		avl := tree.New[int, int]()
+
+		for i := range b.N {
+			avl.Insert(shuffled[i%n], i)
+		}
+	})
+}
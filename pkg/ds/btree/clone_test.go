@@ -0,0 +1,136 @@
+package btree_test
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/btree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloneIsIndependent(t *testing.T) {
+	original, err := btree.NewWithDegree[int, string](2)
+	require.NoError(t, err)
+
+	for key := range 20 {
+		original.Put(key, name(key))
+	}
+
+	clone := original.Clone()
+	clone.Put(100, name(100))
+	clone.Put(3, "three")
+	clone.Delete(5)
+	original.Delete(7)
+
+	value, _ := original.Get(3)
+	assert.Equal(t, name(3), *value)
+
+	_, found := original.Get(100)
+	assert.False(t, found)
+
+	_, found = clone.Get(7)
+	assert.True(t, found)
+
+	assert.Equal(t, 19, original.Len())
+	assert.Equal(t, 20, clone.Len())
+	assert.NoError(t, original.CheckInvariants())
+	assert.NoError(t, clone.CheckInvariants())
+}
+
+func TestCloneSharesNodes(t *testing.T) {
+	original, err := btree.NewWithDegree[int, int](2)
+	require.NoError(t, err)
+
+	for key := range 1000 {
+		original.Put(key, key)
+	}
+
+	clone := original.Clone()
+	all := original.SharedNodes(clone)
+
+	// A write copies only the nodes on its path.
+	clone.Put(500, -1)
+
+	shared := original.SharedNodes(clone)
+	assert.Less(t, shared, all)
+	assert.Greater(t, shared, all*9/10)
+}
+
+// TestClonesAgainstModel applies random writes to a family of clones, checking each against
+// its own map.
+func TestClonesAgainstModel(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+
+	first, err := btree.NewWithDegree[int, string](3)
+	require.NoError(t, err)
+
+	trees := []*btree.BTree[int, string]{first}
+	models := []map[int]string{{}}
+
+	for step := range 3000 {
+		i := rng.IntN(len(trees))
+		key := rng.IntN(200)
+
+		switch rng.IntN(10) {
+		case 0:
+			trees = append(trees, trees[i].Clone())
+			models = append(models, maps.Clone(models[i]))
+		case 1, 2, 3:
+			trees[i].Delete(key)
+			delete(models[i], key)
+		default:
+			trees[i].Put(key, name(step))
+			models[i][key] = name(step)
+		}
+	}
+
+	for i, tree := range trees {
+		require.NoError(t, tree.CheckInvariants())
+		assert.Equal(t, slices.Sorted(maps.Keys(models[i])), keys(tree.All()))
+
+		for key, expected := range models[i] {
+			value, found := tree.Get(key)
+			require.True(t, found)
+			assert.Equal(t, expected, *value)
+		}
+	}
+}
+
+func TestIterateWhileWriting(t *testing.T) {
+	tree := btree.New[int, string]()
+
+	for key := range 1000 {
+		tree.Put(key, name(key))
+	}
+
+	var wg sync.WaitGroup
+
+	for range 4 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			for key := range 1000 {
+				tree.Put(key, "updated")
+				tree.Delete(key + 1000)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			// Every snapshot holds the 1000 keys that are never deleted, in order.
+			assert.Len(t, keys(tree.AscendRange(0, 1000)), 1000)
+			assert.True(t, slices.IsSorted(keys(tree.All())))
+		}()
+	}
+
+	wg.Wait()
+
+	assert.NoError(t, tree.CheckInvariants())
+}
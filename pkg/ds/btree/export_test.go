@@ -0,0 +1,126 @@
+package btree
+
+import (
+	"errors"
+	"fmt"
+)
+
+// errInvariant is an error indicating a B-tree's internal bookkeeping is inconsistent.
+var errInvariant = errors.New("invariant violated")
+
+// CheckInvariants exposes checkInvariants to the btree_test package.
+func (t *BTree[K, V]) CheckInvariants() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.checkInvariants()
+}
+
+// SharedNodes returns the number of nodes reachable from both trees' roots.
+func (t *BTree[K, V]) SharedNodes(other *BTree[K, V]) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	other.mu.Lock()
+	defer other.mu.Unlock()
+
+	nodes := map[*node[K, V]]bool{}
+	t.root.walk(func(n *node[K, V]) { nodes[n] = true })
+
+	shared := 0
+
+	other.root.walk(func(n *node[K, V]) {
+		if nodes[n] {
+			shared++
+		}
+	})
+
+	return shared
+}
+
+// checkInvariants verifies the keys are sorted across the tree, every node other than the root
+// holds between degree-1 and 2*degree-1 items, internal nodes have one more child than items,
+// every leaf is at the same depth and the tree holds len items.
+func (t *BTree[K, V]) checkInvariants() error {
+	if t.root == nil {
+		if t.len != 0 {
+			return fmt.Errorf("%w: empty tree has len %d", errInvariant, t.len)
+		}
+
+		return nil
+	}
+
+	if len(t.root.items) == 0 {
+		return fmt.Errorf("%w: the root is empty", errInvariant)
+	}
+
+	var (
+		prev      *K
+		count     int
+		leafDepth = -1
+	)
+
+	var check func(n *node[K, V], depth int) error
+
+	check = func(n *node[K, V], depth int) error {
+		if n != t.root && (len(n.items) < t.minItems() || len(n.items) > t.maxItems()) {
+			return fmt.Errorf("%w: node at depth %d holds %d items", errInvariant, depth, len(n.items))
+		}
+
+		if n.isLeaf() {
+			if leafDepth == -1 {
+				leafDepth = depth
+			}
+
+			if depth != leafDepth {
+				return fmt.Errorf("%w: leaves at depths %d and %d", errInvariant, leafDepth, depth)
+			}
+		} else if len(n.children) != len(n.items)+1 {
+			return fmt.Errorf("%w: node with %d items has %d children", errInvariant, len(n.items), len(n.children))
+		}
+
+		for i, it := range n.items {
+			if !n.isLeaf() {
+				if err := check(n.children[i], depth+1); err != nil {
+					return err
+				}
+			}
+
+			if prev != nil && *prev >= it.key {
+				return fmt.Errorf("%w: key %v follows %v", errInvariant, it.key, *prev)
+			}
+
+			prev = &n.items[i].key
+			count++
+		}
+
+		if n.isLeaf() {
+			return nil
+		}
+
+		return check(n.children[len(n.items)], depth+1)
+	}
+
+	if err := check(t.root, 0); err != nil {
+		return err
+	}
+
+	if count != t.len {
+		return fmt.Errorf("%w: %d items, len is %d", errInvariant, count, t.len)
+	}
+
+	return nil
+}
+
+// walk visits every node of the subtree, which may be nil.
+func (n *node[K, V]) walk(visit func(*node[K, V])) {
+	if n == nil {
+		return
+	}
+
+	visit(n)
+
+	for _, child := range n.children {
+		child.walk(visit)
+	}
+}
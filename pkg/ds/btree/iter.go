@@ -0,0 +1,166 @@
+// Package btree implements the B-tree data structure.
+package btree
+
+import (
+	"cmp"
+	"iter"
+)
+
+// All returns an iterator over the keys and values in ascending order.
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return t.Ascend()
+}
+
+// Ascend returns an iterator over the keys and values in ascending order.
+// The iterator sees the tree as it was when iteration starts, so the tree may be modified
+// while iterating.
+func (t *BTree[K, V]) Ascend() iter.Seq2[K, V] {
+	return t.ascend(nil, nil)
+}
+
+// AscendFrom returns an iterator over the keys not less than pivot and their values in
+// ascending order, seeking straight to the pivot. The iterator sees the tree as it was when
+// iteration starts, so the tree may be modified while iterating.
+func (t *BTree[K, V]) AscendFrom(pivot K) iter.Seq2[K, V] {
+	return t.ascend(&pivot, nil)
+}
+
+// AscendRange returns an iterator over the keys in [from, to) and their values in ascending
+// order. The iterator sees the tree as it was when iteration starts, so the tree may be
+// modified while iterating.
+func (t *BTree[K, V]) AscendRange(from, to K) iter.Seq2[K, V] {
+	return t.ascend(&from, &to)
+}
+
+// Descend returns an iterator over the keys and values in descending order.
+// The iterator sees the tree as it was when iteration starts, so the tree may be modified
+// while iterating.
+func (t *BTree[K, V]) Descend() iter.Seq2[K, V] {
+	return t.descend(nil, nil)
+}
+
+// DescendFrom returns an iterator over the keys not greater than pivot and their values in
+// descending order, seeking straight to the pivot. The iterator sees the tree as it was when
+// iteration starts, so the tree may be modified while iterating.
+func (t *BTree[K, V]) DescendFrom(pivot K) iter.Seq2[K, V] {
+	return t.descend(&pivot, nil)
+}
+
+// DescendRange returns an iterator over the keys in (to, from] and their values in descending
+// order, mirroring AscendRange. The iterator sees the tree as it was when iteration starts,
+// so the tree may be modified while iterating.
+func (t *BTree[K, V]) DescendRange(from, to K) iter.Seq2[K, V] {
+	return t.descend(&from, &to)
+}
+
+// ascend returns an iterator over the keys in [from, to) in ascending order, where a nil
+// bound is unbounded.
+func (t *BTree[K, V]) ascend(from, to *K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if root := t.freeze(); root != nil {
+			root.ascend(from, to, yield)
+		}
+	}
+}
+
+// descend returns an iterator over the keys in (to, from] in descending order, where a nil
+// bound is unbounded.
+func (t *BTree[K, V]) descend(from, to *K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if root := t.freeze(); root != nil {
+			root.descend(from, to, yield)
+		}
+	}
+}
+
+// freeze returns the root and makes the tree copy its nodes before the next write to them,
+// so the nodes reachable from the returned root never change.
+func (t *BTree[K, V]) freeze() *node[K, V] {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.root != nil {
+		t.owner = &owner{}
+	}
+
+	return t.root
+}
+
+// ascend yields the subtree's items with keys in [from, to) in ascending order. It returns
+// false once the iteration is over, either because yield asked to stop or because a key
+// reached to.
+func (n *node[K, V]) ascend(from, to *K, yield func(K, V) bool) bool {
+	start := 0
+	if from != nil {
+		start, _ = n.find(*from)
+	}
+
+	for i := start; i < len(n.items); i++ {
+		// Only the first child visited can hold keys less than from.
+		if !n.isLeaf() && !n.children[i].ascend(from, to, yield) {
+			return false
+		}
+
+		from = nil
+
+		it := n.items[i]
+		if to != nil && cmp.Compare(it.key, *to) >= 0 {
+			return false
+		}
+
+		if !yield(it.key, it.value) {
+			return false
+		}
+	}
+
+	if n.isLeaf() {
+		return true
+	}
+
+	return n.children[len(n.items)].ascend(from, to, yield)
+}
+
+// descend yields the subtree's items with keys in (to, from] in descending order. It returns
+// false once the iteration is over, either because yield asked to stop or because a key
+// reached to.
+func (n *node[K, V]) descend(from, to *K, yield func(K, V) bool) bool {
+	// Visit children[i] and then items[i-1] for i from start down to 0. If from is a key,
+	// the child after it holds only greater keys and is skipped.
+	start, skipChild := len(n.items), false
+
+	if from != nil {
+		var found bool
+
+		start, found = n.find(*from)
+		if found {
+			start++
+			skipChild = true
+		}
+	}
+
+	for i := start; i >= 0; i-- {
+		// Only the first child visited can hold keys greater than from.
+		if !n.isLeaf() && !(i == start && skipChild) {
+			if !n.children[i].descend(from, to, yield) {
+				return false
+			}
+		}
+
+		from = nil
+
+		if i == 0 {
+			break
+		}
+
+		it := n.items[i-1]
+		if to != nil && cmp.Compare(it.key, *to) <= 0 {
+			return false
+		}
+
+		if !yield(it.key, it.value) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,149 @@
+package btree_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/btree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type IterTestSuite struct {
+	suite.Suite
+	tree *btree.BTree[int, string]
+	// model holds the tree's keys in ascending order.
+	model []int
+}
+
+func TestIterTestSuite(t *testing.T) {
+	suite.Run(t, new(IterTestSuite))
+}
+
+// SetupTest fills a degree 2 tree with the even keys up to 100, which makes it several
+// levels deep.
+func (suite *IterTestSuite) SetupTest() {
+	tree, err := btree.NewWithDegree[int, string](2)
+	require.NoError(suite.T(), err)
+
+	suite.tree = tree
+	suite.model = nil
+
+	for key := 0; key <= 100; key += 2 {
+		suite.tree.Put(key, name(key))
+		suite.model = append(suite.model, key)
+	}
+}
+
+// between returns the model's keys k with low <= k < high in ascending order.
+func (suite *IterTestSuite) between(low, high int) []int {
+	var selected []int
+
+	for _, key := range suite.model {
+		if low <= key && key < high {
+			selected = append(selected, key)
+		}
+	}
+
+	return selected
+}
+
+// reversed returns the keys in descending order.
+func reversed(keys []int) []int {
+	keys = slices.Clone(keys)
+	slices.Reverse(keys)
+
+	return keys
+}
+
+func (suite *IterTestSuite) TestAscendAndDescend() {
+	assert.Equal(suite.T(), suite.model, keys(suite.tree.Ascend()))
+	assert.Equal(suite.T(), suite.model, keys(suite.tree.All()))
+	assert.Equal(suite.T(), reversed(suite.model), keys(suite.tree.Descend()))
+}
+
+func (suite *IterTestSuite) TestAscendFrom() {
+	// Pivots on, between and outside the keys.
+	for pivot := -1; pivot <= 102; pivot++ {
+		assert.Equal(suite.T(), suite.between(pivot, 200), keys(suite.tree.AscendFrom(pivot)), "pivot %d", pivot)
+	}
+}
+
+func (suite *IterTestSuite) TestDescendFrom() {
+	for pivot := -1; pivot <= 102; pivot++ {
+		expected := reversed(suite.between(-200, pivot+1))
+		assert.Equal(suite.T(), expected, keys(suite.tree.DescendFrom(pivot)), "pivot %d", pivot)
+	}
+}
+
+func (suite *IterTestSuite) TestAscendRange() {
+	for from := -1; from <= 102; from += 3 {
+		for to := from - 2; to <= 102; to += 5 {
+			expected := suite.between(from, to)
+			assert.Equal(suite.T(), expected, keys(suite.tree.AscendRange(from, to)), "[%d, %d)", from, to)
+		}
+	}
+}
+
+func (suite *IterTestSuite) TestDescendRange() {
+	for from := -1; from <= 102; from += 3 {
+		for to := from - 40; to <= from+2; to += 5 {
+			expected := reversed(suite.between(to+1, from+1))
+			assert.Equal(suite.T(), expected, keys(suite.tree.DescendRange(from, to)), "(%d, %d]", to, from)
+		}
+	}
+}
+
+func (suite *IterTestSuite) TestValues() {
+	for key, value := range suite.tree.Descend() {
+		assert.Equal(suite.T(), name(key), value)
+	}
+}
+
+func (suite *IterTestSuite) TestStopsEarly() {
+	var visited []int
+
+	for key := range suite.tree.AscendFrom(10) {
+		visited = append(visited, key)
+		if key == 16 {
+			break
+		}
+	}
+
+	assert.Equal(suite.T(), []int{10, 12, 14, 16}, visited)
+
+	visited = nil
+
+	for key := range suite.tree.DescendFrom(11) {
+		visited = append(visited, key)
+		if key == 6 {
+			break
+		}
+	}
+
+	assert.Equal(suite.T(), []int{10, 8, 6}, visited)
+}
+
+func (suite *IterTestSuite) TestModifyWhileIterating() {
+	// The iterator sees the tree as it was when iteration started.
+	var visited []int
+
+	for key := range suite.tree.Ascend() {
+		visited = append(visited, key)
+		suite.tree.Delete(key)
+		suite.tree.Put(key+1, name(key+1))
+	}
+
+	assert.Equal(suite.T(), suite.model, visited)
+	assert.Len(suite.T(), keys(suite.tree.All()), len(suite.model))
+	assert.NoError(suite.T(), suite.tree.CheckInvariants())
+}
+
+func (suite *IterTestSuite) TestEmpty() {
+	empty := btree.New[int, string]()
+
+	assert.Empty(suite.T(), keys(empty.Ascend()))
+	assert.Empty(suite.T(), keys(empty.DescendFrom(3)))
+	assert.Empty(suite.T(), keys(empty.AscendRange(1, 5)))
+}
@@ -0,0 +1,166 @@
+// Package disjointset implements the disjoint-set (union-find) data structure.
+package disjointset
+
+// DisjointSet represents a collection of disjoint sets, also known as union-find.
+// Find uses path compression and Union uses union by rank, so every operation runs in
+// nearly constant amortized time.
+// DisjointSet isn't safe for concurrent use. Use Synchronized when it's shared between goroutines.
+type DisjointSet[T comparable] struct {
+	// index maps every element to its position in the slices below.
+	index map[T]int
+	// items holds the elements in the order they were added.
+	items []T
+	// parent holds the position of every element's parent. Roots are their own parent.
+	parent []int
+	// rank holds an upper bound on the height of every root's tree.
+	rank  []int
+	count int
+}
+
+// New creates a new, empty disjoint-set.
+func New[T comparable]() *DisjointSet[T] {
+	return &DisjointSet[T]{
+		index: make(map[T]int),
+	}
+}
+
+// Add adds a value as a new singleton set. It returns false if the value is already present.
+func (ds *DisjointSet[T]) Add(value T) bool {
+	if _, exists := ds.index[value]; exists {
+		return false
+	}
+
+	ds.add(value)
+
+	return true
+}
+
+// add adds a value that isn't present yet and returns its position.
+func (ds *DisjointSet[T]) add(value T) int {
+	position := len(ds.items)
+
+	ds.index[value] = position
+	ds.items = append(ds.items, value)
+	ds.parent = append(ds.parent, position)
+	ds.rank = append(ds.rank, 0)
+	ds.count++
+
+	return position
+}
+
+// Find returns the representative of the set containing the value. It returns false if the
+// value isn't present.
+func (ds *DisjointSet[T]) Find(value T) (T, bool) {
+	position, exists := ds.index[value]
+	if !exists {
+		var zero T
+
+		return zero, false
+	}
+
+	return ds.items[ds.root(position)], true
+}
+
+// root returns the position of the root of the tree containing position, compressing the path
+// so every node visited points directly at the root.
+func (ds *DisjointSet[T]) root(position int) int {
+	root := position
+	for ds.parent[root] != root {
+		root = ds.parent[root]
+	}
+
+	for ds.parent[position] != root {
+		next := ds.parent[position]
+		ds.parent[position] = root
+		position = next
+	}
+
+	return root
+}
+
+// Union merges the sets containing valueA and valueB, adding either value if it isn't present.
+// It returns true if two different sets were merged and false if they were already the same set.
+func (ds *DisjointSet[T]) Union(valueA, valueB T) bool {
+	rootA := ds.root(ds.positionOrAdd(valueA))
+	rootB := ds.root(ds.positionOrAdd(valueB))
+
+	if rootA == rootB {
+		return false
+	}
+
+	// Attach the shorter tree under the taller one to keep the trees flat.
+	switch {
+	case ds.rank[rootA] < ds.rank[rootB]:
+		ds.parent[rootA] = rootB
+	case ds.rank[rootA] > ds.rank[rootB]:
+		ds.parent[rootB] = rootA
+	default:
+		ds.parent[rootB] = rootA
+		ds.rank[rootA]++
+	}
+
+	ds.count--
+
+	return true
+}
+
+// positionOrAdd returns the position of the value, adding it first if it isn't present.
+func (ds *DisjointSet[T]) positionOrAdd(value T) int {
+	if position, exists := ds.index[value]; exists {
+		return position
+	}
+
+	return ds.add(value)
+}
+
+// Connected returns true if both values are present and belong to the same set.
+func (ds *DisjointSet[T]) Connected(valueA, valueB T) bool {
+	positionA, existsA := ds.index[valueA]
+	positionB, existsB := ds.index[valueB]
+
+	if !existsA || !existsB {
+		return false
+	}
+
+	return ds.root(positionA) == ds.root(positionB)
+}
+
+// Contains checks if the value is present in any of the sets.
+func (ds *DisjointSet[T]) Contains(value T) bool {
+	_, exists := ds.index[value]
+
+	return exists
+}
+
+// Groups returns every set as a slice of its members. Groups are ordered by their earliest
+// added member, and members keep the order they were added in.
+func (ds *DisjointSet[T]) Groups() [][]T {
+	groups := make([][]T, 0, ds.count)
+	// groupOf maps a root's position to the index of its group.
+	groupOf := make(map[int]int, ds.count)
+
+	for position, item := range ds.items {
+		root := ds.root(position)
+
+		group, exists := groupOf[root]
+		if !exists {
+			group = len(groups)
+			groupOf[root] = group
+			groups = append(groups, nil)
+		}
+
+		groups[group] = append(groups[group], item)
+	}
+
+	return groups
+}
+
+// Count returns the number of disjoint sets.
+func (ds *DisjointSet[T]) Count() int {
+	return ds.count
+}
+
+// Len returns the number of values across all the sets.
+func (ds *DisjointSet[T]) Len() int {
+	return len(ds.items)
+}
@@ -0,0 +1,14 @@
+// Package disjointset implements the disjoint-set (union-find) data structure.
+package disjointset
+
+// UnionFinder defines the operations for a disjoint-set.
+type UnionFinder[T comparable] interface {
+	Add(T) bool
+	Find(T) (T, bool)
+	Union(T, T) bool
+	Connected(T, T) bool
+	Contains(T) bool
+	Groups() [][]T
+	Count() int
+	Len() int
+}
@@ -0,0 +1,137 @@
+package disjointset_test
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/disjointset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type DisjointSetTestSuite struct {
+	suite.Suite
+	// Demonstrate that the DisjointSet works with multiple data types
+	set       *disjointset.DisjointSet[int]
+	stringSet *disjointset.DisjointSet[string]
+}
+
+func TestDisjointSetTestSuite(t *testing.T) {
+	suite.Run(t, new(DisjointSetTestSuite))
+}
+
+func (suite *DisjointSetTestSuite) TestNew() {
+	suite.set = disjointset.New[int]()
+
+	assert.NotNil(suite.T(), suite.set)
+	assert.Equal(suite.T(), 0, suite.set.Len())
+	assert.Equal(suite.T(), 0, suite.set.Count())
+}
+
+func (suite *DisjointSetTestSuite) TestAdd() {
+	suite.set = disjointset.New[int]()
+
+	assert.True(suite.T(), suite.set.Add(1))
+	assert.True(suite.T(), suite.set.Add(2))
+	assert.False(suite.T(), suite.set.Add(1))
+	assert.Equal(suite.T(), 2, suite.set.Len())
+	assert.Equal(suite.T(), 2, suite.set.Count())
+}
+
+func (suite *DisjointSetTestSuite) TestFind() {
+	suite.set = disjointset.New[int]()
+	suite.set.Add(1)
+
+	root, found := suite.set.Find(1)
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), 1, root)
+
+	root, found = suite.set.Find(7)
+	assert.False(suite.T(), found)
+	assert.Equal(suite.T(), 0, root)
+}
+
+func (suite *DisjointSetTestSuite) TestUnion() {
+	suite.stringSet = disjointset.New[string]()
+
+	assert.True(suite.T(), suite.stringSet.Union("a", "b"))
+	assert.True(suite.T(), suite.stringSet.Union("c", "d"))
+	assert.True(suite.T(), suite.stringSet.Union("b", "d"))
+	assert.False(suite.T(), suite.stringSet.Union("a", "c"))
+
+	rootA, _ := suite.stringSet.Find("a")
+	rootD, _ := suite.stringSet.Find("d")
+
+	assert.Equal(suite.T(), rootA, rootD)
+	assert.Equal(suite.T(), 4, suite.stringSet.Len())
+	assert.Equal(suite.T(), 1, suite.stringSet.Count())
+}
+
+func (suite *DisjointSetTestSuite) TestConnected() {
+	suite.set = disjointset.New[int]()
+	suite.set.Union(1, 2)
+	suite.set.Union(3, 4)
+	suite.set.Add(5)
+
+	assert.True(suite.T(), suite.set.Connected(1, 2))
+	assert.True(suite.T(), suite.set.Connected(5, 5))
+	assert.False(suite.T(), suite.set.Connected(1, 3))
+	assert.False(suite.T(), suite.set.Connected(1, 99))
+	assert.False(suite.T(), suite.set.Contains(99))
+}
+
+func (suite *DisjointSetTestSuite) TestGroups() {
+	suite.set = disjointset.New[int]()
+
+	for i := range 10 {
+		suite.set.Add(i)
+	}
+
+	// Group the numbers by their remainder modulo 3.
+	for i := 3; i < 10; i++ {
+		suite.set.Union(i, i-3)
+	}
+
+	expectedGroups := [][]int{{0, 3, 6, 9}, {1, 4, 7}, {2, 5, 8}}
+
+	assert.Equal(suite.T(), expectedGroups, suite.set.Groups())
+	assert.Equal(suite.T(), 3, suite.set.Count())
+}
+
+func (suite *DisjointSetTestSuite) TestLongChain() {
+	suite.set = disjointset.New[int]()
+	size := 10000
+
+	for i := 1; i < size; i++ {
+		suite.set.Union(i-1, i)
+	}
+
+	assert.True(suite.T(), suite.set.Connected(0, size-1))
+	assert.Equal(suite.T(), 1, suite.set.Count())
+	assert.Equal(suite.T(), size, suite.set.Len())
+}
+
+func (suite *DisjointSetTestSuite) TestKruskal() {
+	type edge struct {
+		from, to, weight int
+	}
+
+	edges := []edge{
+		{0, 1, 7}, {0, 3, 5}, {1, 2, 8}, {1, 3, 9}, {1, 4, 7}, {2, 4, 5},
+		{3, 4, 15}, {3, 5, 6}, {4, 5, 8}, {4, 6, 9}, {5, 6, 11},
+	}
+	slices.SortFunc(edges, func(a, b edge) int { return cmp.Compare(a.weight, b.weight) })
+
+	suite.set = disjointset.New[int]()
+	total := 0
+
+	for _, e := range edges {
+		if suite.set.Union(e.from, e.to) {
+			total += e.weight
+		}
+	}
+
+	assert.Equal(suite.T(), 39, total)
+	assert.Equal(suite.T(), 1, suite.set.Count())
+}
@@ -0,0 +1,86 @@
+// Package disjointset implements the disjoint-set (union-find) data structure.
+package disjointset
+
+import "sync"
+
+// Synchronized represents a disjoint-set that is safe for concurrent use.
+// Mutex ensures the implementation of Synchronized is thread-safe. Find compresses paths,
+// so even lookups need the exclusive lock.
+type Synchronized[T comparable] struct {
+	set *DisjointSet[T]
+	mu  sync.Mutex
+}
+
+// NewSynchronized creates a new, empty disjoint-set that is safe for concurrent use.
+func NewSynchronized[T comparable]() *Synchronized[T] {
+	return &Synchronized[T]{
+		set: New[T](),
+	}
+}
+
+// Add adds a value as a new singleton set. It returns false if the value is already present.
+func (s *Synchronized[T]) Add(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Add(value)
+}
+
+// Find returns the representative of the set containing the value. It returns false if the
+// value isn't present.
+func (s *Synchronized[T]) Find(value T) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Find(value)
+}
+
+// Union merges the sets containing valueA and valueB, adding either value if it isn't present.
+// It returns true if two different sets were merged and false if they were already the same set.
+func (s *Synchronized[T]) Union(valueA, valueB T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Union(valueA, valueB)
+}
+
+// Connected returns true if both values are present and belong to the same set.
+func (s *Synchronized[T]) Connected(valueA, valueB T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Connected(valueA, valueB)
+}
+
+// Contains checks if the value is present in any of the sets.
+func (s *Synchronized[T]) Contains(value T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Contains(value)
+}
+
+// Groups returns every set as a slice of its members. Groups are ordered by their earliest
+// added member, and members keep the order they were added in.
+func (s *Synchronized[T]) Groups() [][]T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Groups()
+}
+
+// Count returns the number of disjoint sets.
+func (s *Synchronized[T]) Count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Count()
+}
+
+// Len returns the number of values across all the sets.
+func (s *Synchronized[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.set.Len()
+}
@@ -0,0 +1,77 @@
+package disjointset_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/disjointset"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// Both implementations satisfy the UnionFinder interface.
+var (
+	_ disjointset.UnionFinder[int] = (*disjointset.DisjointSet[int])(nil)
+	_ disjointset.UnionFinder[int] = (*disjointset.Synchronized[int])(nil)
+)
+
+type SynchronizedTestSuite struct {
+	suite.Suite
+	set *disjointset.Synchronized[int]
+}
+
+func TestSynchronizedTestSuite(t *testing.T) {
+	suite.Run(t, new(SynchronizedTestSuite))
+}
+
+func (suite *SynchronizedTestSuite) SetupTest() {
+	suite.set = disjointset.NewSynchronized[int]()
+}
+
+func (suite *SynchronizedTestSuite) TestOperations() {
+	assert.True(suite.T(), suite.set.Add(1))
+	assert.False(suite.T(), suite.set.Add(1))
+	assert.True(suite.T(), suite.set.Union(1, 2))
+	assert.True(suite.T(), suite.set.Connected(1, 2))
+	assert.True(suite.T(), suite.set.Contains(2))
+
+	root, found := suite.set.Find(2)
+
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), 1, root)
+	assert.Equal(suite.T(), [][]int{{1, 2}}, suite.set.Groups())
+	assert.Equal(suite.T(), 1, suite.set.Count())
+	assert.Equal(suite.T(), 2, suite.set.Len())
+}
+
+func (suite *SynchronizedTestSuite) TestConcurrentUnions() {
+	goroutines := 8
+	perGoroutine := 500
+
+	var wg sync.WaitGroup
+
+	for g := range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			// Each goroutine chains its own range and links it to the previous range.
+			start := g * perGoroutine
+			for i := start + 1; i < start+perGoroutine; i++ {
+				suite.set.Union(i-1, i)
+				suite.set.Connected(start, i)
+			}
+
+			if g > 0 {
+				suite.set.Union(start-1, start)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(suite.T(), goroutines*perGoroutine, suite.set.Len())
+	assert.Equal(suite.T(), 1, suite.set.Count())
+	assert.True(suite.T(), suite.set.Connected(0, goroutines*perGoroutine-1))
+}
@@ -0,0 +1,167 @@
+// Package fenwick implements the Fenwick tree data structure.
+package fenwick
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Fenwick satisfies the prefix sum interface.
+var _ PrefixSummer[int] = (*Fenwick[int])(nil)
+
+// Fenwick represents a Fenwick tree, also called a binary indexed tree: an array of partial
+// sums that supports changing a value and summing a prefix in O(log n) each.
+// Mutex ensures the implementation of Fenwick is thread-safe.
+type Fenwick[T Number] struct {
+	// tree is 1-indexed: tree[i] holds the sum of the values in (i-lowbit(i), i], where
+	// lowbit(i) is i's lowest set bit. tree[0] is unused.
+	tree []T
+	mu   sync.Mutex
+}
+
+// New creates a Fenwick tree of size values, all zero.
+func New[T Number](size int) (*Fenwick[T], error) {
+	if size < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidSize, size)
+	}
+
+	return &Fenwick[T]{tree: make([]T, size+1)}, nil
+}
+
+// FromSlice creates a Fenwick tree holding the values in O(n).
+func FromSlice[T Number](values []T) *Fenwick[T] {
+	tree := make([]T, len(values)+1)
+	copy(tree[1:], values)
+
+	// Each node passes its partial sum on to the next node covering it.
+	for i := 1; i < len(tree); i++ {
+		if parent := i + lowbit(i); parent < len(tree) {
+			tree[parent] += tree[i]
+		}
+	}
+
+	return &Fenwick[T]{tree: tree}
+}
+
+// Add adds delta to the value at the index.
+func (f *Fenwick[T]) Add(index int, delta T) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.check(index); err != nil {
+		return err
+	}
+
+	f.add(index, delta)
+
+	return nil
+}
+
+// Set sets the value at the index.
+func (f *Fenwick[T]) Set(index int, value T) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.check(index); err != nil {
+		return err
+	}
+
+	f.add(index, value-f.get(index))
+
+	return nil
+}
+
+// Get returns the value at the index.
+func (f *Fenwick[T]) Get(index int) (T, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if err := f.check(index); err != nil {
+		var zero T
+
+		return zero, err
+	}
+
+	return f.get(index), nil
+}
+
+// PrefixSum returns the sum of the values before end, which must be between 0 and Len.
+func (f *Fenwick[T]) PrefixSum(end int) (T, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if end < 0 || end >= len(f.tree) {
+		var zero T
+
+		return zero, fmt.Errorf("%w: %d", ErrIndexOutOfRange, end)
+	}
+
+	return f.prefixSum(end), nil
+}
+
+// RangeSum returns the sum of the values in [from, to).
+func (f *Fenwick[T]) RangeSum(from, to int) (T, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if from < 0 || from > to || to >= len(f.tree) {
+		var zero T
+
+		return zero, fmt.Errorf("%w: [%d, %d)", ErrInvalidRange, from, to)
+	}
+
+	return f.prefixSum(to) - f.prefixSum(from), nil
+}
+
+// Len returns the number of values.
+func (f *Fenwick[T]) Len() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.tree) - 1
+}
+
+// check returns an error if the index doesn't hold a value.
+func (f *Fenwick[T]) check(index int) error {
+	if index < 0 || index >= len(f.tree)-1 {
+		return fmt.Errorf("%w: %d", ErrIndexOutOfRange, index)
+	}
+
+	return nil
+}
+
+// add adds delta to the value at the index and to every partial sum covering it.
+func (f *Fenwick[T]) add(index int, delta T) {
+	for i := index + 1; i < len(f.tree); i += lowbit(i) {
+		f.tree[i] += delta
+	}
+}
+
+// get returns the value at the index by subtracting the partial sums that make up
+// tree[index+1] apart from the value itself.
+func (f *Fenwick[T]) get(index int) T {
+	i := index + 1
+	value := f.tree[i]
+
+	for stop, j := i-lowbit(i), i-1; j > stop; j -= lowbit(j) {
+		value -= f.tree[j]
+	}
+
+	return value
+}
+
+// prefixSum returns the sum of the values before end.
+func (f *Fenwick[T]) prefixSum(end int) T {
+	var sum T
+
+	for i := end; i > 0; i -= lowbit(i) {
+		sum += f.tree[i]
+	}
+
+	return sum
+}
+
+// lowbit returns i's lowest set bit.
+func lowbit(i int) int {
+	return i & -i
+}
@@ -0,0 +1,33 @@
+// Package fenwick implements the Fenwick tree data structure.
+package fenwick
+
+import "errors"
+
+var (
+	// ErrIndexOutOfRange is an error indicating an index outside the tree.
+	ErrIndexOutOfRange = errors.New("index out of range")
+
+	// ErrInvalidRange is an error indicating a range whose start is after its end or that
+	// reaches outside the tree.
+	ErrInvalidRange = errors.New("invalid range")
+
+	// ErrInvalidSize is an error indicating a negative size.
+	ErrInvalidSize = errors.New("invalid size")
+)
+
+// Number is a type the tree can add and subtract.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// PrefixSummer defines the operations for a structure answering prefix sum queries.
+type PrefixSummer[T Number] interface {
+	Add(index int, delta T) error
+	Set(index int, value T) error
+	Get(index int) (T, error)
+	PrefixSum(end int) (T, error)
+	RangeSum(from, to int) (T, error)
+	Len() int
+}
@@ -0,0 +1,195 @@
+package fenwick_test
+
+import (
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/fenwick"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type FenwickTestSuite struct {
+	suite.Suite
+	tree *fenwick.Fenwick[int]
+}
+
+func TestFenwickTestSuite(t *testing.T) {
+	suite.Run(t, new(FenwickTestSuite))
+}
+
+func (suite *FenwickTestSuite) SetupTest() {
+	suite.tree = fenwick.FromSlice([]int{3, 1, 4, 1, 5, 9, 2, 6})
+}
+
+// sum returns the sum of the values in [from, to).
+func sum(values []int, from, to int) int {
+	total := 0
+	for _, value := range values[from:to] {
+		total += value
+	}
+
+	return total
+}
+
+func (suite *FenwickTestSuite) TestNew() {
+	tree, err := fenwick.New[float64](4)
+	require.NoError(suite.T(), err)
+
+	total, err := tree.RangeSum(0, 4)
+	require.NoError(suite.T(), err)
+	assert.Zero(suite.T(), total)
+	assert.Equal(suite.T(), 4, tree.Len())
+
+	tree, err = fenwick.New[float64](-1)
+	assert.Nil(suite.T(), tree)
+	assert.ErrorIs(suite.T(), err, fenwick.ErrInvalidSize)
+}
+
+func (suite *FenwickTestSuite) TestEmpty() {
+	tree, err := fenwick.New[int](0)
+	require.NoError(suite.T(), err)
+
+	total, err := tree.PrefixSum(0)
+	require.NoError(suite.T(), err)
+	assert.Zero(suite.T(), total)
+	assert.ErrorIs(suite.T(), tree.Add(0, 1), fenwick.ErrIndexOutOfRange)
+}
+
+func (suite *FenwickTestSuite) TestPrefixSum() {
+	for end, expected := range []int{0, 3, 4, 8, 9, 14, 23, 25, 31} {
+		total, err := suite.tree.PrefixSum(end)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), expected, total, "end %d", end)
+	}
+}
+
+func (suite *FenwickTestSuite) TestRangeSum() {
+	total, err := suite.tree.RangeSum(2, 6)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 19, total)
+
+	total, err = suite.tree.RangeSum(3, 3)
+	require.NoError(suite.T(), err)
+	assert.Zero(suite.T(), total)
+}
+
+func (suite *FenwickTestSuite) TestAddSetAndGet() {
+	require.NoError(suite.T(), suite.tree.Add(2, 10))
+	require.NoError(suite.T(), suite.tree.Set(5, 0))
+
+	value, err := suite.tree.Get(2)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 14, value)
+
+	value, err = suite.tree.Get(5)
+	require.NoError(suite.T(), err)
+	assert.Zero(suite.T(), value)
+
+	total, _ := suite.tree.PrefixSum(8)
+	assert.Equal(suite.T(), 32, total)
+}
+
+func (suite *FenwickTestSuite) TestInvalidIndexes() {
+	for _, index := range []int{-1, 8} {
+		assert.ErrorIs(suite.T(), suite.tree.Add(index, 1), fenwick.ErrIndexOutOfRange)
+		assert.ErrorIs(suite.T(), suite.tree.Set(index, 1), fenwick.ErrIndexOutOfRange)
+
+		_, err := suite.tree.Get(index)
+		assert.ErrorIs(suite.T(), err, fenwick.ErrIndexOutOfRange)
+	}
+
+	_, err := suite.tree.PrefixSum(9)
+	require.ErrorIs(suite.T(), err, fenwick.ErrIndexOutOfRange)
+
+	for _, bounds := range [][2]int{{-1, 2}, {3, 2}, {0, 9}} {
+		_, err = suite.tree.RangeSum(bounds[0], bounds[1])
+		assert.ErrorIs(suite.T(), err, fenwick.ErrInvalidRange, "range %v", bounds)
+	}
+}
+
+func (suite *FenwickTestSuite) TestAgainstBruteForce() {
+	rng := rand.New(rand.NewPCG(12, 13))
+
+	for _, size := range []int{1, 2, 7, 64, 100} {
+		model := make([]int, size)
+		for i := range model {
+			model[i] = rng.IntN(200) - 100
+		}
+
+		tree := fenwick.FromSlice(model)
+
+		for step := range 2000 {
+			index := rng.IntN(size)
+
+			switch rng.IntN(5) {
+			case 0:
+				delta := rng.IntN(200) - 100
+				model[index] += delta
+
+				require.NoError(suite.T(), tree.Add(index, delta))
+			case 1:
+				value := rng.IntN(200) - 100
+				model[index] = value
+
+				require.NoError(suite.T(), tree.Set(index, value))
+			case 2:
+				value, err := tree.Get(index)
+				require.NoError(suite.T(), err)
+				require.Equal(suite.T(), model[index], value, "size %d step %d", size, step)
+			default:
+				from := rng.IntN(size + 1)
+				to := from + rng.IntN(size-from+1)
+
+				total, err := tree.RangeSum(from, to)
+				require.NoError(suite.T(), err)
+				require.Equal(suite.T(), sum(model, from, to), total, "size %d step %d", size, step)
+			}
+		}
+	}
+}
+
+func (suite *FenwickTestSuite) TestFromSliceMatchesAdds() {
+	values := []int{5, -2, 7, 0, 3, 3, -8, 1, 9, 4, 2}
+	tree := fenwick.FromSlice(values)
+
+	added, err := fenwick.New[int](len(values))
+	require.NoError(suite.T(), err)
+
+	for i, value := range values {
+		require.NoError(suite.T(), added.Add(i, value))
+	}
+
+	for end := range len(values) + 1 {
+		expected, _ := added.PrefixSum(end)
+		actual, _ := tree.PrefixSum(end)
+		assert.Equal(suite.T(), expected, actual, "end %d", end)
+	}
+}
+
+func (suite *FenwickTestSuite) TestConcurrentAdds() {
+	tree, err := fenwick.New[int64](16)
+	require.NoError(suite.T(), err)
+
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range 160 {
+				_ = tree.Add(i%16, 1)
+				_, _ = tree.PrefixSum(i % 17)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	total, _ := tree.PrefixSum(16)
+	assert.Equal(suite.T(), int64(8*160), total)
+}
@@ -0,0 +1,97 @@
+// Package functional implements functional helpers over the ds containers.
+//
+// Every helper iterates over a snapshot of the container, so the callbacks may safely
+// use or modify the container itself. The helpers that produce values return an
+// iter.Seq, which can be collected into a new container with set.Collect,
+// stack.Collect, queue.Collect or slices.Collect.
+package functional
+
+import "iter"
+
+// Filter returns an iterator over the items of src for which keep returns true. src is read
+// when iteration starts, not when Filter is called, and again every time iteration restarts.
+func Filter[T any](src Iterable[T], keep func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range src.All() {
+			if keep(item) && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns an iterator over the result of calling transform on every item of src. src is
+// read when iteration starts, not when Map is called, and again every time iteration restarts.
+func Map[T, U any](src Iterable[T], transform func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for item := range src.All() {
+			if !yield(transform(item)) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce combines the items of src into a single value, starting from initial and
+// calling combine with the running value and every item in turn.
+func Reduce[T, U any](src Iterable[T], initial U, combine func(U, T) U) U {
+	result := initial
+	for item := range src.All() {
+		result = combine(result, item)
+	}
+
+	return result
+}
+
+// Any returns true if predicate returns true for at least one item of src.
+// It stops at the first match and returns false for an empty container.
+func Any[T any](src Iterable[T], predicate func(T) bool) bool {
+	for item := range src.All() {
+		if predicate(item) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// All returns true if predicate returns true for every item of src.
+// It stops at the first mismatch and returns true for an empty container.
+func All[T any](src Iterable[T], predicate func(T) bool) bool {
+	for item := range src.All() {
+		if !predicate(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Partition splits the items of src into the ones for which predicate returns true
+// and the ones for which it returns false, keeping the iteration order.
+func Partition[T any](src Iterable[T], predicate func(T) bool) ([]T, []T) {
+	var matched, unmatched []T
+
+	for item := range src.All() {
+		if predicate(item) {
+			matched = append(matched, item)
+		} else {
+			unmatched = append(unmatched, item)
+		}
+	}
+
+	return matched, unmatched
+}
+
+// GroupBy groups the items of src by the key returned for each of them, keeping the
+// iteration order within every group.
+func GroupBy[T any, K comparable](src Iterable[T], key func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+
+	for item := range src.All() {
+		k := key(item)
+		groups[k] = append(groups[k], item)
+	}
+
+	return groups
+}
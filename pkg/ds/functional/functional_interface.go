@@ -0,0 +1,11 @@
+// Package functional implements functional helpers over the ds containers.
+package functional
+
+import "iter"
+
+// Iterable defines the operation shared by every container the helpers work with.
+// All must return an iterator over a consistent snapshot of the container's items,
+// copied under the container's lock, so callbacks never run while the lock is held.
+type Iterable[T any] interface {
+	All() iter.Seq[T]
+}
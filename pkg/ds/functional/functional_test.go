@@ -0,0 +1,170 @@
+package functional_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/functional"
+	"github.com/dqfan2012/playground/pkg/ds/hashmap"
+	"github.com/dqfan2012/playground/pkg/ds/queue"
+	"github.com/dqfan2012/playground/pkg/ds/set"
+	"github.com/dqfan2012/playground/pkg/ds/stack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// Every container satisfies the Iterable interface.
+var (
+	_ functional.Iterable[int]                = (*set.Set[int])(nil)
+	_ functional.Iterable[int]                = (*stack.Stack[int])(nil)
+	_ functional.Iterable[int]                = (*queue.Queue[int])(nil)
+	_ functional.Iterable[queue.Item[int]]    = (*queue.PriorityQueue[int])(nil)
+	_ functional.Iterable[hashmap.Entry[int]] = (*hashmap.HashMap[int])(nil)
+)
+
+type FunctionalTestSuite struct {
+	suite.Suite
+	set   *set.Set[int]
+	stack *stack.Stack[int]
+	queue *queue.Queue[int]
+}
+
+func TestFunctionalTestSuite(t *testing.T) {
+	suite.Run(t, new(FunctionalTestSuite))
+}
+
+func (suite *FunctionalTestSuite) SetupTest() {
+	suite.set = set.New[int]()
+	suite.stack = stack.New[int]()
+	suite.queue = queue.New[int]()
+
+	for i := 1; i <= 6; i++ {
+		suite.set.Add(i)
+		suite.stack.Push(i)
+		suite.queue.Enqueue(i)
+	}
+}
+
+func isEven(value int) bool {
+	return value%2 == 0
+}
+
+func (suite *FunctionalTestSuite) TestFilter() {
+	evens := set.Collect(functional.Filter(suite.set, isEven))
+
+	assert.Equal(suite.T(), 3, evens.Len())
+	assert.True(suite.T(), evens.Contains(4))
+	assert.False(suite.T(), evens.Contains(3))
+
+	assert.Equal(suite.T(), []int{2, 4, 6}, slices.Collect(functional.Filter(suite.stack, isEven)))
+	assert.Equal(suite.T(), []int{2, 4, 6}, slices.Collect(functional.Filter(suite.queue, isEven)))
+}
+
+func (suite *FunctionalTestSuite) TestMap() {
+	squares := stack.Collect(functional.Map(suite.stack, func(value int) int { return value * value }))
+	top, _ := squares.Pop()
+
+	assert.Equal(suite.T(), 36, *top)
+	assert.Equal(suite.T(), 5, squares.Len())
+
+	labels := queue.Collect(functional.Map(suite.queue, func(value int) string {
+		if isEven(value) {
+			return "even"
+		}
+
+		return "odd"
+	}))
+	front, _ := labels.Dequeue()
+
+	assert.Equal(suite.T(), "odd", *front)
+	assert.Equal(suite.T(), 5, labels.Len())
+}
+
+func (suite *FunctionalTestSuite) TestReduce() {
+	sum := func(total, value int) int { return total + value }
+
+	assert.Equal(suite.T(), 21, functional.Reduce(suite.set, 0, sum))
+	assert.Equal(suite.T(), 21, functional.Reduce(suite.stack, 0, sum))
+	assert.Equal(suite.T(), "123456", functional.Reduce(suite.queue, "", func(text string, value int) string {
+		return text + string(rune('0'+value))
+	}))
+}
+
+func (suite *FunctionalTestSuite) TestAnyAll() {
+	assert.True(suite.T(), functional.Any(suite.set, isEven))
+	assert.False(suite.T(), functional.All(suite.set, isEven))
+	assert.True(suite.T(), functional.All(suite.queue, func(value int) bool { return value > 0 }))
+	assert.False(suite.T(), functional.Any(suite.stack, func(value int) bool { return value > 6 }))
+
+	empty := set.New[int]()
+
+	assert.False(suite.T(), functional.Any(empty, isEven))
+	assert.True(suite.T(), functional.All(empty, isEven))
+}
+
+func (suite *FunctionalTestSuite) TestPartition() {
+	evens, odds := functional.Partition(suite.queue, isEven)
+
+	assert.Equal(suite.T(), []int{2, 4, 6}, evens)
+	assert.Equal(suite.T(), []int{1, 3, 5}, odds)
+}
+
+func (suite *FunctionalTestSuite) TestGroupBy() {
+	groups := functional.GroupBy(suite.stack, func(value int) int { return value % 3 })
+
+	assert.Equal(suite.T(), map[int][]int{0: {3, 6}, 1: {1, 4}, 2: {2, 5}}, groups)
+}
+
+func (suite *FunctionalTestSuite) TestPriorityQueue() {
+	priorityQueue := queue.NewPriorityQueue[string]()
+	priorityQueue.Enqueue("low", 3)
+	priorityQueue.Enqueue("high", 1)
+	priorityQueue.Enqueue("medium", 2)
+
+	values := slices.Collect(functional.Map(priorityQueue, func(item queue.Item[string]) string {
+		return item.Value
+	}))
+
+	assert.Equal(suite.T(), []string{"high", "medium", "low"}, values)
+}
+
+func (suite *FunctionalTestSuite) TestHashMap() {
+	prices := hashmap.NewHashMap[int](4)
+	prices.Insert("apple", 3)
+	prices.Insert("melon", 7)
+	prices.Insert("pear", 4)
+
+	total := functional.Reduce(prices, 0, func(total int, entry hashmap.Entry[int]) int {
+		return total + entry.Value
+	})
+
+	cheap, _ := functional.Partition(prices, func(entry hashmap.Entry[int]) bool { return entry.Value < 5 })
+	names := slices.Sorted(functional.Map(prices, func(entry hashmap.Entry[int]) string { return entry.Key }))
+
+	assert.Equal(suite.T(), 14, total)
+	assert.Len(suite.T(), cheap, 2)
+	assert.Equal(suite.T(), []string{"apple", "melon", "pear"}, names)
+}
+
+func (suite *FunctionalTestSuite) TestReadsWhenIterationStarts() {
+	evens := functional.Filter(suite.queue, isEven)
+	doubled := functional.Map(suite.queue, func(value int) int { return value * 2 })
+
+	// Items added after the call but before iteration are seen.
+	suite.queue.Enqueue(8)
+
+	assert.Equal(suite.T(), []int{2, 4, 6, 8}, slices.Collect(evens))
+	assert.Equal(suite.T(), []int{2, 4, 6, 8, 10, 12, 16}, slices.Collect(doubled))
+}
+
+func (suite *FunctionalTestSuite) TestCallbacksMayModifyContainer() {
+	// The helpers iterate over a snapshot, so the callback can use the container's lock.
+	count := functional.Reduce(suite.set, 0, func(count, value int) int {
+		suite.set.Add(value * 10)
+
+		return count + 1
+	})
+
+	assert.Equal(suite.T(), 6, count)
+	assert.Equal(suite.T(), 12, suite.set.Len())
+}
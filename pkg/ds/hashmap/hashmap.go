@@ -1,7 +1,10 @@
 // Package hashmap implements the HashMap data structure.
 package hashmap
 
-import "sync"
+import (
+	"iter"
+	"sync"
+)
 
 // MapNode implements a node in the HashMap.
 type MapNode[T comparable] struct {
@@ -10,6 +13,12 @@ type MapNode[T comparable] struct {
 	next  *MapNode[T]
 }
 
+// Entry is a key and its value, as produced by All.
+type Entry[T comparable] struct {
+	Key   string
+	Value T
+}
+
 // HashMap implements a thread-safe HashMap.
 type HashMap[T comparable] struct {
 	buckets []*MapNode[T]
@@ -107,3 +116,32 @@ func (h *HashMap[T]) Delete(key string) {
 		current = current.next
 	}
 }
+
+// All returns an iterator over the entries in the HashMap, bucket by bucket, so in no
+// particular order. The entries are copied under the lock when iteration starts, so the
+// HashMap may be modified while iterating.
+func (h *HashMap[T]) All() iter.Seq[Entry[T]] {
+	return func(yield func(Entry[T]) bool) {
+		for _, entry := range h.snapshot() {
+			if !yield(entry) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot returns a copy of the entries in the HashMap in bucket order.
+func (h *HashMap[T]) snapshot() []Entry[T] {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var entries []Entry[T]
+
+	for _, bucket := range h.buckets {
+		for current := bucket; current != nil; current = current.next {
+			entries = append(entries, Entry[T]{Key: current.key, Value: current.value})
+		}
+	}
+
+	return entries
+}
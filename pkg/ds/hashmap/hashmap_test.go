@@ -128,3 +128,31 @@ func (suite *HashMapTestSuite) TestEmptyHashMap() {
 	assert.False(suite.T(), exists)
 	assert.Nil(suite.T(), value)
 }
+
+func (suite *HashMapTestSuite) TestAll() {
+	suite.hashmap = hashmap.NewHashMap[int](2)
+
+	suite.hashmap.Insert("one", 1)
+	suite.hashmap.Insert("two", 2)
+	suite.hashmap.Insert("three", 3)
+	suite.hashmap.Insert("two", 20)
+	suite.hashmap.Delete("one")
+
+	entries := map[string]int{}
+
+	for entry := range suite.hashmap.All() {
+		// Inserting while iterating doesn't change what the iteration sees.
+		suite.hashmap.Insert(entry.Key+"!", entry.Value)
+
+		entries[entry.Key] = entry.Value
+	}
+
+	assert.Equal(suite.T(), map[string]int{"two": 20, "three": 3}, entries)
+
+	count := 0
+	for range suite.hashmap.All() {
+		count++
+	}
+
+	assert.Equal(suite.T(), 4, count)
+}
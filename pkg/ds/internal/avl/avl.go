@@ -0,0 +1,100 @@
+// Package avl implements the rebalancing shared by the AVL-based trees in pkg/ds.
+package avl
+
+// Node is a node of an AVL tree, used through its pointer type P so the trees can keep their
+// own node types. Height must return 0 for a nil node, and Update must recompute the node's
+// height, along with anything else the tree records about the subtree, from its children.
+type Node[P any] interface {
+	comparable
+	Left() P
+	Right() P
+	SetLeft(left P)
+	SetRight(right P)
+	Height() int
+	Update()
+}
+
+// Rebalance restores the balance of a node whose subtrees differ in height by at most two,
+// updating it, and returns the subtree's new root. Call it on every node on the way back up
+// from an insertion or removal.
+func Rebalance[P Node[P]](root P) P {
+	switch balance := root.Left().Height() - root.Right().Height(); {
+	case balance > 1:
+		// A left subtree leaning right needs a double rotation.
+		if left := root.Left(); left.Left().Height() < left.Right().Height() {
+			root.SetLeft(rotateLeft(left))
+		}
+
+		return rotateRight(root)
+	case balance < -1:
+		if right := root.Right(); right.Right().Height() < right.Left().Height() {
+			root.SetRight(rotateRight(right))
+		}
+
+		return rotateLeft(root)
+	default:
+		root.Update()
+
+		return root
+	}
+}
+
+// RemoveRoot unlinks the root of a subtree, putting its successor in its place if it has two
+// children, and returns the subtree's new, rebalanced root.
+func RemoveRoot[P Node[P]](root P) P {
+	var empty P
+
+	if root.Left() == empty {
+		return root.Right()
+	}
+
+	if root.Right() == empty {
+		return root.Left()
+	}
+
+	// The successor is the smallest node of the right subtree.
+	right, successor := removeMin(root.Right())
+	successor.SetLeft(root.Left())
+	successor.SetRight(right)
+
+	return Rebalance(successor)
+}
+
+// removeMin unlinks the smallest node of a subtree and returns the subtree's new root and
+// the unlinked node.
+func removeMin[P Node[P]](root P) (P, P) {
+	var empty P
+
+	if root.Left() == empty {
+		return root.Right(), root
+	}
+
+	left, smallest := removeMin(root.Left())
+	root.SetLeft(left)
+
+	return Rebalance(root), smallest
+}
+
+// rotateLeft makes the root's right child the subtree's new root and returns it.
+func rotateLeft[P Node[P]](root P) P {
+	pivot := root.Right()
+	root.SetRight(pivot.Left())
+	pivot.SetLeft(root)
+
+	root.Update()
+	pivot.Update()
+
+	return pivot
+}
+
+// rotateRight makes the root's left child the subtree's new root and returns it.
+func rotateRight[P Node[P]](root P) P {
+	pivot := root.Left()
+	root.SetLeft(pivot.Right())
+	pivot.SetRight(root)
+
+	root.Update()
+	pivot.Update()
+
+	return pivot
+}
@@ -0,0 +1,149 @@
+package avl_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/internal/avl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// node is the smallest tree node avl can balance: a key and a height.
+type node struct {
+	key         int
+	left, right *node
+	height      int
+}
+
+func (n *node) Left() *node {
+	return n.left
+}
+
+func (n *node) Right() *node {
+	return n.right
+}
+
+func (n *node) SetLeft(left *node) {
+	n.left = left
+}
+
+func (n *node) SetRight(right *node) {
+	n.right = right
+}
+
+func (n *node) Update() {
+	n.height = 1 + max(n.left.Height(), n.right.Height())
+}
+
+func (n *node) Height() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+func insert(root *node, key int) *node {
+	switch {
+	case root == nil:
+		return &node{key: key, height: 1}
+	case key < root.key:
+		root.left = insert(root.left, key)
+	case key > root.key:
+		root.right = insert(root.right, key)
+	default:
+		return root
+	}
+
+	return avl.Rebalance(root)
+}
+
+func remove(root *node, key int) *node {
+	switch {
+	case root == nil:
+		return nil
+	case key < root.key:
+		root.left = remove(root.left, key)
+	case key > root.key:
+		root.right = remove(root.right, key)
+	default:
+		return avl.RemoveRoot(root)
+	}
+
+	return avl.Rebalance(root)
+}
+
+// check returns the keys of the subtree in order, failing if a node is out of balance or
+// has a stale height.
+func check(t *testing.T, root *node) []int {
+	t.Helper()
+
+	if root == nil {
+		return nil
+	}
+
+	require.Equal(t, 1+max(root.left.Height(), root.right.Height()), root.height, "key %d", root.key)
+	require.LessOrEqual(t, abs(root.left.Height()-root.right.Height()), 1, "key %d", root.key)
+
+	return append(append(check(t, root.left), root.key), check(t, root.right)...)
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
+
+type AVLTestSuite struct {
+	suite.Suite
+}
+
+func TestAVLTestSuite(t *testing.T) {
+	suite.Run(t, new(AVLTestSuite))
+}
+
+func (suite *AVLTestSuite) TestSortedInsertsStayBalanced() {
+	var root *node
+
+	for key := range 1024 {
+		root = insert(root, key)
+	}
+
+	check(suite.T(), root)
+
+	// 1024 keys fit in an AVL tree no more than 1.44 log2(1026) high.
+	assert.LessOrEqual(suite.T(), root.Height(), 14)
+}
+
+func (suite *AVLTestSuite) TestAgainstModel() {
+	rng := rand.New(rand.NewPCG(1, 2))
+
+	var root *node
+
+	model := map[int]bool{}
+
+	for range 5000 {
+		key := rng.IntN(200)
+
+		if rng.IntN(2) == 0 {
+			root = insert(root, key)
+			model[key] = true
+		} else {
+			root = remove(root, key)
+			delete(model, key)
+		}
+
+		expected := make([]int, 0, len(model))
+		for key := range model {
+			expected = append(expected, key)
+		}
+
+		slices.Sort(expected)
+		require.Equal(suite.T(), expected, append([]int{}, check(suite.T(), root)...))
+	}
+}
@@ -0,0 +1,92 @@
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// errInvariant is an error indicating an interval tree's internal bookkeeping is inconsistent.
+var errInvariant = errors.New("invariant violated")
+
+// CheckInvariants exposes checkInvariants to the interval_test package.
+func (t *Tree[K, V]) CheckInvariants() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.checkInvariants()
+}
+
+// Height returns the number of nodes on the longest path from the root to a leaf.
+func (t *Tree[K, V]) Height() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.root.Height()
+}
+
+// checkInvariants verifies the intervals are sorted and valid, every node holds distinct
+// values, every node's height and maxEnd match its children's, no node's subtrees differ in
+// height by more than one and the tree holds len values.
+func (t *Tree[K, V]) checkInvariants() error {
+	var (
+		prev  *node[K, V]
+		count int
+		err   error
+	)
+
+	inOrder(t.root, func(n *node[K, V]) {
+		if err != nil {
+			return
+		}
+
+		err = t.checkNode(n, prev)
+		prev = n
+		count += len(n.values)
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if count != t.len {
+		return fmt.Errorf("%w: %d values, len is %d", errInvariant, count, t.len)
+	}
+
+	return nil
+}
+
+// checkNode verifies a node's own invariants and that it sorts after prev.
+func (t *Tree[K, V]) checkNode(n, prev *node[K, V]) error {
+	if n.end < n.start {
+		return fmt.Errorf("%w: interval [%v, %v] ends before it starts", errInvariant, n.start, n.end)
+	}
+
+	if prev != nil && n.compare(prev.start, prev.end) >= 0 {
+		return fmt.Errorf("%w: [%v, %v] follows [%v, %v]", errInvariant, n.start, n.end, prev.start, prev.end)
+	}
+
+	if len(n.values) == 0 {
+		return fmt.Errorf("%w: [%v, %v] holds no values", errInvariant, n.start, n.end)
+	}
+
+	for i, value := range n.values {
+		if slices.Contains(n.values[i+1:], value) {
+			return fmt.Errorf("%w: [%v, %v] holds %v twice", errInvariant, n.start, n.end, value)
+		}
+	}
+
+	expected := *n
+	expected.Update()
+
+	if n.height != expected.height || n.maxEnd != expected.maxEnd {
+		return fmt.Errorf("%w: [%v, %v] has height %d and maxEnd %v, expected %d and %v",
+			errInvariant, n.start, n.end, n.height, n.maxEnd, expected.height, expected.maxEnd)
+	}
+
+	if balance := n.left.Height() - n.right.Height(); balance < -1 || balance > 1 {
+		return fmt.Errorf("%w: [%v, %v] has balance factor %d", errInvariant, n.start, n.end, balance)
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+// Package interval implements the interval tree data structure.
+package interval
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/dqfan2012/playground/pkg/ds/internal/avl"
+)
+
+// Tree satisfies the interval search interface.
+var _ Searcher[int, string] = (*Tree[int, string])(nil)
+
+// Interval is a closed interval [Start, End] and the value stored with it.
+type Interval[K cmp.Ordered, V any] struct {
+	Start, End K
+	Value      V
+}
+
+// Overlaps returns true if the interval shares at least one point with [lo, hi].
+func (i Interval[K, V]) Overlaps(lo, hi K) bool {
+	return i.Start <= hi && lo <= i.End
+}
+
+// Contains returns true if the point lies within the interval.
+func (i Interval[K, V]) Contains(point K) bool {
+	return i.Start <= point && point <= i.End
+}
+
+// Tree represents an interval tree: an AVL tree of intervals ordered by their start, then
+// their end, where every node also records the largest end in its subtree. That lets overlap
+// queries skip every subtree that ends before the query starts.
+// Mutex ensures the implementation of Tree is thread-safe.
+type Tree[K cmp.Ordered, V comparable] struct {
+	root *node[K, V]
+	len  int
+	mu   sync.Mutex
+}
+
+// node holds the values of every interval with the same start and end. height is the number
+// of nodes on the longest path down to a leaf, and maxEnd is the largest end in the subtree.
+type node[K cmp.Ordered, V comparable] struct {
+	start, end  K
+	values      []V
+	left, right *node[K, V]
+	height      int
+	maxEnd      K
+}
+
+// New creates a new empty interval tree.
+func New[K cmp.Ordered, V comparable]() *Tree[K, V] {
+	return &Tree[K, V]{}
+}
+
+// Insert adds the interval [start, end] with the value. The same interval may be inserted
+// with different values; inserting it again with the same value does nothing and returns
+// false. It returns an error if end is before start or either is NaN.
+func (t *Tree[K, V]) Insert(start, end K, value V) (bool, error) {
+	if isNaN(start) || isNaN(end) || end < start {
+		return false, fmt.Errorf("%w: [%v, %v]", ErrInvalidInterval, start, end)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var inserted bool
+
+	t.root, inserted = insert(t.root, start, end, value)
+	if inserted {
+		t.len++
+	}
+
+	return inserted, nil
+}
+
+// Delete removes the interval [start, end] with the value. It returns false if it isn't present.
+func (t *Tree[K, V]) Delete(start, end K, value V) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var deleted bool
+
+	t.root, deleted = remove(t.root, start, end, value)
+	if deleted {
+		t.len--
+	}
+
+	return deleted
+}
+
+// Clear removes every interval.
+func (t *Tree[K, V]) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.root = nil
+	t.len = 0
+}
+
+// IsEmpty returns true if the tree holds no intervals.
+func (t *Tree[K, V]) IsEmpty() bool {
+	return t.Len() == 0
+}
+
+// Len returns the number of intervals, counting each value separately.
+func (t *Tree[K, V]) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.len
+}
+
+// insert adds the value for [start, end] to the subtree and returns the subtree's new root
+// and whether the value is new.
+func insert[K cmp.Ordered, V comparable](root *node[K, V], start, end K, value V) (*node[K, V], bool) {
+	if root == nil {
+		return &node[K, V]{start: start, end: end, values: []V{value}, height: 1, maxEnd: end}, true
+	}
+
+	var inserted bool
+
+	switch order := root.compare(start, end); {
+	case order < 0:
+		root.left, inserted = insert(root.left, start, end, value)
+	case order > 0:
+		root.right, inserted = insert(root.right, start, end, value)
+	default:
+		if slices.Contains(root.values, value) {
+			return root, false
+		}
+
+		root.values = append(root.values, value)
+
+		return root, true
+	}
+
+	return avl.Rebalance(root), inserted
+}
+
+// remove removes the value for [start, end] from the subtree and returns the subtree's new
+// root and whether the value was present.
+func remove[K cmp.Ordered, V comparable](root *node[K, V], start, end K, value V) (*node[K, V], bool) {
+	if root == nil {
+		return nil, false
+	}
+
+	var deleted bool
+
+	switch order := root.compare(start, end); {
+	case order < 0:
+		root.left, deleted = remove(root.left, start, end, value)
+	case order > 0:
+		root.right, deleted = remove(root.right, start, end, value)
+	default:
+		i := slices.Index(root.values, value)
+		if i == -1 {
+			return root, false
+		}
+
+		if len(root.values) > 1 {
+			root.values = slices.Delete(root.values, i, i+1)
+
+			return root, true
+		}
+
+		return avl.RemoveRoot(root), true
+	}
+
+	return avl.Rebalance(root), deleted
+}
+
+// Left returns the node's left child.
+func (n *node[K, V]) Left() *node[K, V] {
+	return n.left
+}
+
+// Right returns the node's right child.
+func (n *node[K, V]) Right() *node[K, V] {
+	return n.right
+}
+
+// SetLeft replaces the node's left child.
+func (n *node[K, V]) SetLeft(left *node[K, V]) {
+	n.left = left
+}
+
+// SetRight replaces the node's right child.
+func (n *node[K, V]) SetRight(right *node[K, V]) {
+	n.right = right
+}
+
+// Height returns the number of nodes on the longest path down to a leaf, or 0 for nil.
+func (n *node[K, V]) Height() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+// Update recomputes the height and maxEnd after the node's children change, keeping maxEnd
+// the largest end anywhere in the subtree.
+func (n *node[K, V]) Update() {
+	n.height = 1 + max(n.left.Height(), n.right.Height())
+	n.maxEnd = n.end
+
+	for _, child := range []*node[K, V]{n.left, n.right} {
+		if child != nil {
+			n.maxEnd = max(n.maxEnd, child.maxEnd)
+		}
+	}
+}
+
+// compare orders [start, end] against the node's interval, by start and then by end.
+func (n *node[K, V]) compare(start, end K) int {
+	return cmp.Or(cmp.Compare(start, n.start), cmp.Compare(end, n.end))
+}
+
+// isNaN returns true if x is a floating-point NaN, the only value not equal to itself. NaN
+// compares false with everything, so it would break the tree's ordering and maxEnd.
+func isNaN[K cmp.Ordered](x K) bool {
+	return x != x
+}
@@ -0,0 +1,25 @@
+// Package interval implements the interval tree data structure.
+package interval
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+)
+
+// ErrInvalidInterval is an error indicating an interval that ends before it starts or has a
+// NaN endpoint.
+var ErrInvalidInterval = errors.New("invalid interval")
+
+// Searcher defines the operations for a collection of intervals answering overlap queries.
+type Searcher[K cmp.Ordered, V comparable] interface {
+	Insert(start, end K, value V) (bool, error)
+	Delete(start, end K, value V) bool
+	Overlaps(lo, hi K) bool
+	Overlapping(lo, hi K) iter.Seq[Interval[K, V]]
+	Containing(point K) iter.Seq[Interval[K, V]]
+	All() iter.Seq[Interval[K, V]]
+	Clear()
+	IsEmpty() bool
+	Len() int
+}
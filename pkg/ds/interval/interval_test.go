@@ -0,0 +1,190 @@
+package interval_test
+
+import (
+	"cmp"
+	"iter"
+	"math"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/interval"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type IntervalTestSuite struct {
+	suite.Suite
+	tree *interval.Tree[int, string]
+}
+
+func TestIntervalTestSuite(t *testing.T) {
+	suite.Run(t, new(IntervalTestSuite))
+}
+
+func (suite *IntervalTestSuite) SetupTest() {
+	suite.tree = interval.New[int, string]()
+}
+
+func (suite *IntervalTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.tree.CheckInvariants())
+}
+
+// insert inserts the interval, failing the test if it isn't new.
+func (suite *IntervalTestSuite) insert(start, end int, value string) {
+	inserted, err := suite.tree.Insert(start, end, value)
+	require.NoError(suite.T(), err)
+	require.True(suite.T(), inserted)
+}
+
+// values collects the values of the intervals produced by an iterator.
+func values[K int | uint32](seq func(yield func(interval.Interval[K, string]) bool)) []string {
+	var collected []string
+
+	for i := range seq {
+		collected = append(collected, i.Value)
+	}
+
+	return collected
+}
+
+func (suite *IntervalTestSuite) TestNew() {
+	assert.True(suite.T(), suite.tree.IsEmpty())
+	assert.Equal(suite.T(), 0, suite.tree.Len())
+	assert.Empty(suite.T(), values(suite.tree.All()))
+}
+
+func (suite *IntervalTestSuite) TestInsertInvalid() {
+	inserted, err := suite.tree.Insert(5, 4, "backwards")
+
+	assert.False(suite.T(), inserted)
+	assert.ErrorIs(suite.T(), err, interval.ErrInvalidInterval)
+	assert.True(suite.T(), suite.tree.IsEmpty())
+
+	// A single point is a valid interval.
+	suite.insert(5, 5, "point")
+}
+
+func (suite *IntervalTestSuite) TestSameIntervalWithSeveralValues() {
+	suite.insert(1, 5, "a")
+	suite.insert(1, 5, "b")
+
+	inserted, err := suite.tree.Insert(1, 5, "a")
+	require.NoError(suite.T(), err)
+	assert.False(suite.T(), inserted)
+	assert.Equal(suite.T(), 2, suite.tree.Len())
+	assert.Equal(suite.T(), []string{"a", "b"}, values(suite.tree.All()))
+
+	assert.True(suite.T(), suite.tree.Delete(1, 5, "a"))
+	assert.Equal(suite.T(), []string{"b"}, values(suite.tree.All()))
+}
+
+func (suite *IntervalTestSuite) TestDelete() {
+	suite.insert(1, 5, "a")
+	suite.insert(2, 3, "b")
+	suite.insert(4, 9, "c")
+
+	assert.True(suite.T(), suite.tree.Delete(2, 3, "b"))
+	assert.False(suite.T(), suite.tree.Delete(2, 3, "b"))
+	assert.False(suite.T(), suite.tree.Delete(1, 5, "z"))
+	assert.False(suite.T(), suite.tree.Delete(1, 6, "a"))
+
+	assert.Equal(suite.T(), []string{"a", "c"}, values(suite.tree.All()))
+	assert.Equal(suite.T(), 2, suite.tree.Len())
+}
+
+func (suite *IntervalTestSuite) TestAllInStartOrder() {
+	suite.insert(5, 6, "d")
+	suite.insert(1, 9, "b")
+	suite.insert(1, 2, "a")
+	suite.insert(3, 4, "c")
+
+	assert.Equal(suite.T(), []string{"a", "b", "c", "d"}, values(suite.tree.All()))
+}
+
+func (suite *IntervalTestSuite) TestClear() {
+	suite.insert(1, 2, "a")
+
+	suite.tree.Clear()
+
+	assert.True(suite.T(), suite.tree.IsEmpty())
+	assert.False(suite.T(), suite.tree.Overlaps(math.MinInt, math.MaxInt))
+}
+
+func (suite *IntervalTestSuite) TestSortedInsertsStayBalanced() {
+	const n = 1 << 12
+
+	for start := range n {
+		_, _ = suite.tree.Insert(start, start+10, "")
+	}
+
+	// An AVL tree with n nodes is at most 1.44 log2(n+2) high.
+	assert.LessOrEqual(suite.T(), suite.tree.Height(), int(1.4405*math.Log2(n+2)))
+}
+
+func (suite *IntervalTestSuite) TestConcurrentInserts() {
+	var wg sync.WaitGroup
+
+	for worker := range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range 100 {
+				start := worker*100 + i
+				_, _ = suite.tree.Insert(start, start+5, "")
+				suite.tree.Overlaps(start, start)
+
+				for range suite.tree.Containing(start) {
+					break
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(suite.T(), 800, suite.tree.Len())
+}
+
+func (suite *IntervalTestSuite) TestFloatEndpointsRejectNaN() {
+	tree := interval.New[float64, int]()
+
+	for i := range 8 {
+		start := float64(i)
+		_, err := tree.Insert(start, start+0.5, i)
+		require.NoError(suite.T(), err)
+	}
+
+	nan := math.NaN()
+
+	for _, bounds := range [][2]float64{{nan, 1}, {1, nan}, {nan, nan}} {
+		inserted, err := tree.Insert(bounds[0], bounds[1], -1)
+
+		assert.False(suite.T(), inserted)
+		assert.ErrorIs(suite.T(), err, interval.ErrInvalidInterval)
+
+		assert.False(suite.T(), tree.Overlaps(bounds[0], bounds[1]))
+		assert.Empty(suite.T(), collect(tree.Overlapping(bounds[0], bounds[1])))
+	}
+
+	assert.Empty(suite.T(), collect(tree.Containing(nan)))
+	assert.Equal(suite.T(), 8, tree.Len())
+	require.NoError(suite.T(), tree.CheckInvariants())
+
+	// The rejected inserts leave the maxEnd augmentation intact.
+	assert.Equal(suite.T(), []int{3, 4}, collect(tree.Overlapping(3.25, 4.25)))
+	assert.False(suite.T(), tree.Overlaps(7.75, 100))
+}
+
+// collect returns the values of the intervals in seq.
+func collect[K cmp.Ordered](seq iter.Seq[interval.Interval[K, int]]) []int {
+	var collected []int
+
+	for i := range seq {
+		collected = append(collected, i.Value)
+	}
+
+	return collected
+}
@@ -0,0 +1,123 @@
+// Package interval implements the interval tree data structure.
+package interval
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Overlaps returns true if any interval shares at least one point with [lo, hi], which is
+// enough to detect a scheduling conflict. It returns false if hi is before lo or either is
+// NaN.
+func (t *Tree[K, V]) Overlaps(lo, hi K) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if isNaN(lo) || isNaN(hi) || hi < lo {
+		return false
+	}
+
+	// Go left whenever the left subtree reaches lo. If it then holds no overlap, an interval
+	// in it reaching lo must start after hi, and so must everything to its right.
+	for current := t.root; current != nil; {
+		if current.start <= hi && lo <= current.end {
+			return true
+		}
+
+		if current.left != nil && current.left.maxEnd >= lo {
+			current = current.left
+		} else {
+			current = current.right
+		}
+	}
+
+	return false
+}
+
+// Overlapping returns an iterator over the intervals that share at least one point with
+// [lo, hi], in start order. It yields nothing if hi is before lo or either is NaN. The
+// intervals are read under the lock when iteration starts, so the tree may be modified while
+// iterating.
+func (t *Tree[K, V]) Overlapping(lo, hi K) iter.Seq[Interval[K, V]] {
+	return t.snapshot(func(visit func(*node[K, V])) {
+		if !isNaN(lo) && !isNaN(hi) && lo <= hi {
+			overlapping(t.root, lo, hi, visit)
+		}
+	})
+}
+
+// Containing returns an iterator over the intervals that contain the point, in start order.
+// The intervals are read under the lock when iteration starts, so the tree may be modified
+// while iterating.
+func (t *Tree[K, V]) Containing(point K) iter.Seq[Interval[K, V]] {
+	return t.Overlapping(point, point)
+}
+
+// All returns an iterator over the intervals in start order, then end order. Intervals that
+// are the same come in the order their values were inserted. The intervals are read under
+// the lock when iteration starts, so the tree may be modified while iterating.
+func (t *Tree[K, V]) All() iter.Seq[Interval[K, V]] {
+	return t.snapshot(func(visit func(*node[K, V])) {
+		inOrder(t.root, visit)
+	})
+}
+
+// snapshot returns an iterator over copies of the intervals in the nodes that walk visits,
+// taken under the lock when iteration starts.
+func (t *Tree[K, V]) snapshot(walk func(visit func(*node[K, V]))) iter.Seq[Interval[K, V]] {
+	return func(yield func(Interval[K, V]) bool) {
+		for _, interval := range t.collect(walk) {
+			if !yield(interval) {
+				return
+			}
+		}
+	}
+}
+
+// collect returns copies of the intervals in the nodes that walk visits, in visiting order.
+func (t *Tree[K, V]) collect(walk func(visit func(*node[K, V]))) []Interval[K, V] {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var intervals []Interval[K, V]
+
+	walk(func(n *node[K, V]) {
+		for _, value := range n.values {
+			intervals = append(intervals, Interval[K, V]{Start: n.start, End: n.end, Value: value})
+		}
+	})
+
+	return intervals
+}
+
+// overlapping visits the subtree's nodes overlapping [lo, hi] in order, skipping subtrees
+// that end before lo or start after hi.
+func overlapping[K cmp.Ordered, V comparable](root *node[K, V], lo, hi K, visit func(*node[K, V])) {
+	if root == nil || root.maxEnd < lo {
+		return
+	}
+
+	overlapping(root.left, lo, hi, visit)
+
+	// Everything to the right starts no earlier than the root.
+	if root.start > hi {
+		return
+	}
+
+	if lo <= root.end {
+		visit(root)
+	}
+
+	overlapping(root.right, lo, hi, visit)
+}
+
+// inOrder visits the subtree's nodes in order.
+func inOrder[K cmp.Ordered, V comparable](root *node[K, V], visit func(*node[K, V])) {
+	if root == nil {
+		return
+	}
+
+	inOrder(root.left, visit)
+	visit(root)
+	inOrder(root.right, visit)
+}
@@ -0,0 +1,167 @@
+package interval_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/interval"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type QueryTestSuite struct {
+	suite.Suite
+	tree *interval.Tree[int, string]
+}
+
+func TestQueryTestSuite(t *testing.T) {
+	suite.Run(t, new(QueryTestSuite))
+}
+
+// SetupTest books meetings, in minutes since midnight.
+func (suite *QueryTestSuite) SetupTest() {
+	suite.tree = interval.New[int, string]()
+
+	for _, meeting := range []interval.Interval[int, string]{
+		{Start: 540, End: 600, Value: "standup"},
+		{Start: 570, End: 660, Value: "design review"},
+		{Start: 720, End: 780, Value: "lunch"},
+		{Start: 600, End: 615, Value: "one-on-one"},
+		{Start: 900, End: 1020, Value: "planning"},
+	} {
+		_, err := suite.tree.Insert(meeting.Start, meeting.End, meeting.Value)
+		require.NoError(suite.T(), err)
+	}
+}
+
+func (suite *QueryTestSuite) TestOverlapping() {
+	assert.Equal(suite.T(), []string{"standup", "design review", "one-on-one"},
+		values(suite.tree.Overlapping(590, 610)))
+	assert.Equal(suite.T(), []string{"lunch", "planning"}, values(suite.tree.Overlapping(700, 2000)))
+	assert.Empty(suite.T(), values(suite.tree.Overlapping(800, 899)))
+	assert.Empty(suite.T(), values(suite.tree.Overlapping(0, 539)))
+}
+
+func (suite *QueryTestSuite) TestOverlappingIncludesEndpoints() {
+	// Intervals are closed, so touching at an endpoint counts as overlapping.
+	assert.Equal(suite.T(), []string{"standup", "design review", "one-on-one"},
+		values(suite.tree.Overlapping(600, 600)))
+	assert.Equal(suite.T(), []string{"planning"}, values(suite.tree.Overlapping(1020, 1100)))
+}
+
+func (suite *QueryTestSuite) TestOverlappingBackwardsRange() {
+	assert.Empty(suite.T(), values(suite.tree.Overlapping(610, 590)))
+	assert.False(suite.T(), suite.tree.Overlaps(610, 590))
+}
+
+func (suite *QueryTestSuite) TestContaining() {
+	assert.Equal(suite.T(), []string{"standup", "design review"}, values(suite.tree.Containing(580)))
+	assert.Equal(suite.T(), []string{"lunch"}, values(suite.tree.Containing(720)))
+	assert.Empty(suite.T(), values(suite.tree.Containing(700)))
+}
+
+func (suite *QueryTestSuite) TestOverlaps() {
+	assert.True(suite.T(), suite.tree.Overlaps(650, 700))
+	assert.True(suite.T(), suite.tree.Overlaps(1020, 1020))
+	assert.False(suite.T(), suite.tree.Overlaps(661, 719))
+	assert.False(suite.T(), suite.tree.Overlaps(1021, 1440))
+}
+
+func (suite *QueryTestSuite) TestStopsEarly() {
+	var visited []string
+
+	for meeting := range suite.tree.Overlapping(0, 2000) {
+		visited = append(visited, meeting.Value)
+		if meeting.Value == "design review" {
+			break
+		}
+	}
+
+	assert.Equal(suite.T(), []string{"standup", "design review"}, visited)
+}
+
+func (suite *QueryTestSuite) TestModifyWhileIterating() {
+	for meeting := range suite.tree.All() {
+		suite.tree.Delete(meeting.Start, meeting.End, meeting.Value)
+	}
+
+	assert.True(suite.T(), suite.tree.IsEmpty())
+	assert.NoError(suite.T(), suite.tree.CheckInvariants())
+}
+
+func (suite *QueryTestSuite) TestIPRanges() {
+	// IPv4 ranges as 32-bit integers: 10.0.0.0/8 contains 10.1.0.0/16.
+	routes := interval.New[uint32, string]()
+
+	_, _ = routes.Insert(0x0A000000, 0x0AFFFFFF, "10.0.0.0/8")
+	_, _ = routes.Insert(0x0A010000, 0x0A01FFFF, "10.1.0.0/16")
+	_, _ = routes.Insert(0xC0A80000, 0xC0A8FFFF, "192.168.0.0/16")
+
+	assert.Equal(suite.T(), []string{"10.0.0.0/8", "10.1.0.0/16"}, values(routes.Containing(0x0A010203)))
+	assert.Equal(suite.T(), []string{"10.0.0.0/8"}, values(routes.Containing(0x0A020304)))
+	assert.Empty(suite.T(), values(routes.Containing(0x08080808)))
+}
+
+func (suite *QueryTestSuite) TestAgainstBruteForce() {
+	rng := rand.New(rand.NewPCG(20, 21))
+	tree := interval.New[int, int]()
+
+	var model []interval.Interval[int, int]
+
+	randomInterval := func() interval.Interval[int, int] {
+		start := rng.IntN(100)
+
+		return interval.Interval[int, int]{Start: start, End: start + rng.IntN(20), Value: rng.IntN(3)}
+	}
+
+	for step := range 3000 {
+		candidate := randomInterval()
+		index := slices.Index(model, candidate)
+
+		if rng.IntN(3) == 0 {
+			deleted := tree.Delete(candidate.Start, candidate.End, candidate.Value)
+			require.Equal(suite.T(), index != -1, deleted)
+
+			if deleted {
+				model = slices.Delete(model, index, index+1)
+			}
+		} else {
+			inserted, err := tree.Insert(candidate.Start, candidate.End, candidate.Value)
+			require.NoError(suite.T(), err)
+			require.Equal(suite.T(), index == -1, inserted)
+
+			if inserted {
+				model = append(model, candidate)
+			}
+		}
+
+		require.NoError(suite.T(), tree.CheckInvariants(), "step %d", step)
+
+		lo := rng.IntN(130) - 5
+		hi := lo + rng.IntN(15)
+
+		var expected []interval.Interval[int, int]
+
+		for _, candidate := range model {
+			if candidate.Overlaps(lo, hi) {
+				expected = append(expected, candidate)
+			}
+		}
+
+		// The tree yields intervals by start, then end, then insertion order.
+		slices.SortStableFunc(expected, func(a, b interval.Interval[int, int]) int {
+			if a.Start != b.Start {
+				return a.Start - b.Start
+			}
+
+			return a.End - b.End
+		})
+
+		require.Equal(suite.T(), expected, slices.Collect(tree.Overlapping(lo, hi)), "step %d [%d, %d]", step, lo, hi)
+		require.Equal(suite.T(), len(expected) > 0, tree.Overlaps(lo, hi), "step %d [%d, %d]", step, lo, hi)
+	}
+
+	assert.Equal(suite.T(), len(model), tree.Len())
+}
@@ -0,0 +1,252 @@
+// Package linkedlist implements the Linked List Data Structure.
+package linkedlist
+
+import "fmt"
+
+// Reverse reverses the list in place.
+func (list *Single[T]) Reverse() {
+	var prev *ListNode[T]
+
+	list.Tail = list.Head
+
+	for node := list.Head; node != nil; {
+		next := node.Next
+		node.Next = prev
+		prev = node
+		node = next
+	}
+
+	list.Head = prev
+}
+
+// Reverse reverses the list in place.
+func (list *Double[T]) Reverse() {
+	for node := list.Head; node != nil; node = node.Prev {
+		node.Next, node.Prev = node.Prev, node.Next
+	}
+
+	list.Head, list.Tail = list.Tail, list.Head
+}
+
+// Concat moves other's nodes to the end of the list in O(1), leaving other empty.
+// Concatenating a list with itself does nothing.
+func (list *Single[T]) Concat(other *Single[T]) {
+	if other == list || other.IsEmpty() {
+		return
+	}
+
+	if list.IsEmpty() {
+		list.Head = other.Head
+	} else {
+		list.Tail.Next = other.Head
+	}
+
+	list.Tail = other.Tail
+	list.len += other.len
+	other.ClearList()
+}
+
+// Concat moves other's nodes to the end of the list in O(1), leaving other empty.
+// Concatenating a list with itself does nothing.
+func (list *Double[T]) Concat(other *Double[T]) {
+	if other == list || other.IsEmpty() {
+		return
+	}
+
+	if list.IsEmpty() {
+		list.Head = other.Head
+	} else {
+		list.Tail.Next = other.Head
+		other.Head.Prev = list.Tail
+	}
+
+	list.Tail = other.Tail
+	list.adopt(other)
+}
+
+// SplitAt keeps the nodes before position in the list and moves the rest to a new list,
+// which it returns. The position must be between 0 and Len inclusive.
+func (list *Single[T]) SplitAt(position int) (*Single[T], error) {
+	if list.isInvalidPosition(position) {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	rest := NewSingleEmpty[T]()
+
+	if position == list.len {
+		return rest, nil
+	}
+
+	if position == 0 {
+		*rest = *list
+		list.ClearList()
+
+		return rest, nil
+	}
+
+	last := list.nodeAt(position - 1)
+	rest.Head, rest.Tail, rest.len = last.Next, list.Tail, list.len-position
+	last.Next = nil
+	list.Tail, list.len = last, position
+
+	return rest, nil
+}
+
+// SplitAt keeps the nodes before position in the list and moves the rest to a new list,
+// which it returns. The position must be between 0 and Len inclusive. Node handles stay
+// valid and follow their nodes into the new list.
+func (list *Double[T]) SplitAt(position int) (*Double[T], error) {
+	if list.isInvalidPosition(position) {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	rest := NewDoubleEmpty[T]()
+
+	if position == list.len {
+		return rest, nil
+	}
+
+	if position == 0 {
+		// Handing over the id moves every node without touching them.
+		*rest = *list
+		list.ClearList()
+
+		return rest, nil
+	}
+
+	first := list.nodeAt(position)
+	last := first.Prev
+	rest.Head, rest.Tail, rest.len = first, list.Tail, list.len-position
+	last.Next, first.Prev = nil, nil
+	list.Tail, list.len = last, position
+
+	id := rest.identity()
+	for node := first; node != nil; node = node.Next {
+		node.owner = id
+	}
+
+	return rest, nil
+}
+
+// Rotate rotates the list left by k positions, so the node at position k becomes the head.
+// A negative k rotates the list right. Rotating an empty list does nothing.
+func (list *Single[T]) Rotate(k int) {
+	k = list.rotation(k)
+	if k == 0 {
+		return
+	}
+
+	last := list.nodeAt(k - 1)
+	list.Tail.Next = list.Head
+	list.Head = last.Next
+	list.Tail = last
+	last.Next = nil
+}
+
+// Rotate rotates the list left by k positions, so the node at position k becomes the head.
+// A negative k rotates the list right. Rotating an empty list does nothing.
+func (list *Double[T]) Rotate(k int) {
+	k = list.rotation(k)
+	if k == 0 {
+		return
+	}
+
+	first := list.nodeAt(k)
+	list.Tail.Next = list.Head
+	list.Head.Prev = list.Tail
+	list.Head = first
+	list.Tail = first.Prev
+	list.Tail.Next = nil
+	first.Prev = nil
+}
+
+// Splice moves other's nodes into the list before position, leaving other empty.
+// The position must be between 0 and Len inclusive. Splicing a list into itself does nothing.
+func (list *Single[T]) Splice(position int, other *Single[T]) error {
+	if list.isInvalidPosition(position) {
+		return fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	if other == list || other.IsEmpty() {
+		return nil
+	}
+
+	if position == list.len {
+		list.Concat(other)
+
+		return nil
+	}
+
+	if position == 0 {
+		other.Tail.Next = list.Head
+		list.Head = other.Head
+	} else {
+		prev := list.nodeAt(position - 1)
+		other.Tail.Next = prev.Next
+		prev.Next = other.Head
+	}
+
+	list.len += other.len
+	other.ClearList()
+
+	return nil
+}
+
+// Splice moves other's nodes into the list before position, leaving other empty.
+// The position must be between 0 and Len inclusive. Splicing a list into itself does nothing.
+// Node handles stay valid and follow their nodes into the list.
+func (list *Double[T]) Splice(position int, other *Double[T]) error {
+	if list.isInvalidPosition(position) {
+		return fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	if other == list || other.IsEmpty() {
+		return nil
+	}
+
+	if position == list.len {
+		list.Concat(other)
+
+		return nil
+	}
+
+	next := list.nodeAt(position)
+	if next.Prev == nil {
+		list.Head = other.Head
+	} else {
+		next.Prev.Next = other.Head
+		other.Head.Prev = next.Prev
+	}
+
+	other.Tail.Next = next
+	next.Prev = other.Tail
+	list.adopt(other)
+
+	return nil
+}
+
+// rotation normalizes k to a left rotation in [0, Len).
+func (list *Single[T]) rotation(k int) int {
+	if list.len == 0 {
+		return 0
+	}
+
+	return (k%list.len + list.len) % list.len
+}
+
+// rotation normalizes k to a left rotation in [0, Len).
+func (list *Double[T]) rotation(k int) int {
+	if list.len == 0 {
+		return 0
+	}
+
+	return (k%list.len + list.len) % list.len
+}
+
+// adopt takes ownership of other's nodes, which must already be linked into the list,
+// and leaves other empty. Forwarding other's id makes this O(1).
+func (list *Double[T]) adopt(other *Double[T]) {
+	other.id.merged = list.identity()
+	list.len += other.len
+	other.ClearList()
+}
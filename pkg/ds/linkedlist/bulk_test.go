@@ -0,0 +1,178 @@
+package linkedlist_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/linkedlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bulkList is a list with the bulk operations, where L is the list's own pointer type.
+type bulkList[L any] interface {
+	checkedList
+	Reverse()
+	Concat(other L)
+	SplitAt(position int) (L, error)
+	Rotate(k int)
+	Splice(position int, other L) error
+}
+
+// TestSingleBulkAgainstSliceModel compares the bulk operations on Single against a plain slice.
+func TestSingleBulkAgainstSliceModel(t *testing.T) {
+	for seed := range uint64(5) {
+		runBulkModel(t, linkedlist.NewSingleEmpty[int], seed)
+	}
+}
+
+// TestDoubleBulkAgainstSliceModel compares the bulk operations on Double against a plain slice.
+func TestDoubleBulkAgainstSliceModel(t *testing.T) {
+	for seed := range uint64(5) {
+		runBulkModel(t, linkedlist.NewDoubleEmpty[int], seed)
+	}
+}
+
+// runBulkModel applies random bulk operations to both a list and a slice, checking after every
+// operation that they agree and that the invariants of every list involved hold.
+func runBulkModel[L bulkList[L]](t *testing.T, newList func() L, seed uint64) {
+	t.Helper()
+
+	rng := rand.New(rand.NewPCG(seed, seed))
+	list := newList()
+	model := []int{}
+
+	// randomList returns a list of up to 5 random values along with its model.
+	randomList := func() (L, []int) {
+		other := newList()
+		values := []int{}
+
+		for range rng.IntN(6) {
+			value := rng.IntN(100)
+			other.InsertTail(value)
+			values = append(values, value)
+		}
+
+		return other, values
+	}
+
+	for step := range modelOperations / 5 {
+		// Positions deliberately include invalid ones just outside the list.
+		position := rng.IntN(len(model)+3) - 1
+
+		switch rng.IntN(5) {
+		case 0:
+			list.Reverse()
+			slices.Reverse(model)
+		case 1:
+			other, values := randomList()
+			list.Concat(other)
+			model = append(model, values...)
+
+			require.NoError(t, other.CheckInvariants())
+			assert.True(t, other.IsEmpty())
+		case 2:
+			rest, err := list.SplitAt(position)
+			if position < 0 || position > len(model) {
+				require.ErrorIs(t, err, linkedlist.ErrInvalidPosition)
+
+				break
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, rest.CheckInvariants())
+			require.Equal(t, model[position:], listValues(rest))
+
+			// Keep one of the halves at random so the list both shrinks and survives.
+			if rng.IntN(2) == 0 {
+				model = model[:position]
+			} else {
+				list = rest
+				model = slices.Clone(model[position:])
+			}
+		case 3:
+			k := rng.IntN(4*len(model)+1) - 2*len(model)
+			list.Rotate(k)
+
+			if len(model) > 0 {
+				k = (k%len(model) + len(model)) % len(model)
+				model = append(model[k:], model[:k]...)
+			}
+		default:
+			other, values := randomList()
+
+			err := list.Splice(position, other)
+			if position < 0 || position > len(model) {
+				require.ErrorIs(t, err, linkedlist.ErrInvalidPosition)
+				assert.Equal(t, len(values), other.Len())
+
+				break
+			}
+
+			require.NoError(t, err)
+			require.NoError(t, other.CheckInvariants())
+			assert.True(t, other.IsEmpty())
+
+			model = slices.Insert(model, position, values...)
+		}
+
+		require.NoError(t, list.CheckInvariants(), "seed %d step %d", seed, step)
+		require.Equal(t, model, listValues(list), "seed %d step %d", seed, step)
+		require.Equal(t, len(model), list.Len())
+	}
+}
+
+func TestConcatWithItself(t *testing.T) {
+	single := linkedlist.NewSingleEmpty[int]()
+	single.InsertTail(1)
+	single.Concat(single)
+	require.NoError(t, single.Splice(0, single))
+
+	double := linkedlist.NewDoubleEmpty[int]()
+	double.InsertTail(1)
+	double.Concat(double)
+	require.NoError(t, double.Splice(1, double))
+
+	assert.Equal(t, []int{1}, listValues(single))
+	assert.Equal(t, []int{1}, listValues(double))
+	assert.NoError(t, single.CheckInvariants())
+	assert.NoError(t, double.CheckInvariants())
+}
+
+func TestBulkOperationsKeepNodeHandles(t *testing.T) {
+	list := linkedlist.NewDoubleEmpty[int]()
+	other := linkedlist.NewDoubleEmpty[int]()
+	one := list.InsertTailNode(1)
+	two := other.InsertTailNode(2)
+
+	// Nodes moved by Concat belong to the list they were moved into.
+	list.Concat(other)
+	require.NoError(t, list.MoveToFront(two))
+	require.ErrorIs(t, other.MoveToFront(two), linkedlist.ErrNodeNotInList)
+
+	// Nodes moved by Splice do too, even after several hops.
+	third := linkedlist.NewDoubleEmpty[int]()
+	three := third.InsertTailNode(3)
+	other.Concat(third)
+	require.NoError(t, list.Splice(1, other))
+	require.NoError(t, list.MoveToBack(three))
+	assert.Equal(t, []int{2, 1, 3}, listValues(list))
+
+	// Nodes moved by SplitAt belong to the new list only.
+	rest, err := list.SplitAt(1)
+	require.NoError(t, err)
+	require.ErrorIs(t, list.MoveToFront(three), linkedlist.ErrNodeNotInList)
+	require.NoError(t, rest.MoveToFront(three))
+	require.NoError(t, list.MoveToBack(two))
+	assert.Equal(t, []int{3, 1}, listValues(rest))
+
+	// Clearing the list disowns the nodes it adopted.
+	rest.ClearList()
+
+	_, err = rest.Remove(one)
+	require.ErrorIs(t, err, linkedlist.ErrNodeNotInList)
+
+	assert.NoError(t, list.CheckInvariants())
+	assert.NoError(t, rest.CheckInvariants())
+}
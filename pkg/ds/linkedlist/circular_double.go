@@ -0,0 +1,287 @@
+// Package linkedlist implements the Linked List Data Structure.
+package linkedlist
+
+import (
+	"fmt"
+	"iter"
+)
+
+// CircularDouble satisfies the circular linked list interface.
+var _ Circular[int] = (*CircularDouble[int])(nil)
+
+// CircularDouble represents a circular doubly linked list. The tail's Next points back at
+// the head and the head's Prev at the tail, so walking either never reaches nil; use All
+// or Backward to visit every node once.
+type CircularDouble[T comparable] struct {
+	tail, current *ListNode[T]
+	len           int
+}
+
+// NewCircularDoubleEmpty creates a new circular linked list without any nodes.
+func NewCircularDoubleEmpty[T comparable]() *CircularDouble[T] {
+	return &CircularDouble[T]{}
+}
+
+// ClearList clears the Linked List.
+func (list *CircularDouble[T]) ClearList() {
+	list.tail = nil
+	list.current = nil
+	list.len = 0
+}
+
+// DeleteAtPosition deletes the node at the specified position and returns its data.
+func (list *CircularDouble[T]) DeleteAtPosition(position int) (T, error) {
+	if position < 0 || position >= list.len {
+		var zero T
+
+		return zero, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	node := list.nodeAt(position)
+	list.unlink(node)
+
+	return node.Data, nil
+}
+
+// DeleteHead deletes the head node.
+func (list *CircularDouble[T]) DeleteHead() (T, bool) {
+	if list.IsEmpty() {
+		var zero T
+
+		return zero, false
+	}
+
+	head := list.tail.Next
+	list.unlink(head)
+
+	return head.Data, true
+}
+
+// DeleteTail deletes the tail node.
+func (list *CircularDouble[T]) DeleteTail() (T, bool) {
+	if list.IsEmpty() {
+		var zero T
+
+		return zero, false
+	}
+
+	tail := list.tail
+	list.unlink(tail)
+
+	return tail.Data, true
+}
+
+// InsertAtPosition inserts a node at the specified position.
+func (list *CircularDouble[T]) InsertAtPosition(position int, data T) (bool, error) {
+	if position < 0 || position > list.len {
+		return false, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	if position == 0 {
+		return list.InsertHead(data), nil
+	}
+
+	if position == list.len {
+		return list.InsertTail(data), nil
+	}
+
+	list.linkAfter(list.nodeAt(position-1), NewListNodeWithData(data))
+
+	return true, nil
+}
+
+// InsertHead inserts a node at the head of the list.
+func (list *CircularDouble[T]) InsertHead(data T) bool {
+	list.linkAfter(list.tail, NewListNodeWithData(data))
+
+	return true
+}
+
+// InsertTail inserts a node at the tail of the list.
+func (list *CircularDouble[T]) InsertTail(data T) bool {
+	// A node linked after the tail is the new head. Moving the tail onto it makes it the tail.
+	node := NewListNodeWithData(data)
+	list.linkAfter(list.tail, node)
+	list.tail = node
+
+	return true
+}
+
+// IsEmpty returns whether the linked list is empty.
+func (list *CircularDouble[T]) IsEmpty() bool {
+	return list.Len() == 0
+}
+
+// IsValuePresent checks if a value is present in the list.
+func (list *CircularDouble[T]) IsValuePresent(data T) bool {
+	for value := range list.All() {
+		if value == data {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetHeadIfEmpty sets the head if the list is empty.
+func (list *CircularDouble[T]) SetHeadIfEmpty(newHead *ListNode[T]) bool {
+	if list.IsEmpty() {
+		list.linkAfter(nil, newHead)
+
+		return true
+	}
+
+	return false
+}
+
+// GetHead retrieves the head node of the linked list.
+func (list *CircularDouble[T]) GetHead() *ListNode[T] {
+	if list.tail == nil {
+		return nil
+	}
+
+	return list.tail.Next
+}
+
+// GetTail retrieves the tail node of the linked list.
+func (list *CircularDouble[T]) GetTail() *ListNode[T] {
+	return list.tail
+}
+
+// Len retrieves the length of the linked list.
+func (list *CircularDouble[T]) Len() int {
+	return list.len
+}
+
+// All returns an iterator over the data in the list from head to tail, stopping after one lap.
+// The next node is read before yielding, so the current node may be removed while iterating.
+func (list *CircularDouble[T]) All() iter.Seq[T] {
+	return lap(list.GetHead, list.Len)
+}
+
+// Backward returns an iterator over the data in the list from tail to head, stopping after
+// one lap. The previous node is read before yielding, so the current node may be removed
+// while iterating.
+func (list *CircularDouble[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		node := list.tail
+
+		for range list.len {
+			if node == nil {
+				return
+			}
+
+			prev := node.Prev
+			if !yield(node.Data) {
+				return
+			}
+
+			node = prev
+		}
+	}
+}
+
+// Current returns the data at the current node. It returns false if the list is empty.
+// The current node starts at the first node inserted into an empty list.
+func (list *CircularDouble[T]) Current() (T, bool) {
+	if list.IsEmpty() {
+		var zero T
+
+		return zero, false
+	}
+
+	return list.current.Data, true
+}
+
+// Advance moves the current node forward by steps, wrapping around the ring.
+// A negative number of steps moves it backward. It takes the shorter way around.
+func (list *CircularDouble[T]) Advance(steps int) {
+	list.current = list.walk(list.current, steps)
+}
+
+// RemoveCurrent removes the current node and returns its data. The next node becomes current.
+// It returns false if the list is empty.
+func (list *CircularDouble[T]) RemoveCurrent() (T, bool) {
+	if list.IsEmpty() {
+		var zero T
+
+		return zero, false
+	}
+
+	current := list.current
+	list.unlink(current)
+
+	return current.Data, true
+}
+
+// Rotate rotates the list left by k positions, so the node at position k becomes the head.
+// A negative k rotates the list right. The current node doesn't change.
+func (list *CircularDouble[T]) Rotate(k int) {
+	list.tail = list.walk(list.tail, k)
+}
+
+// walk returns the node steps after node, wrapping around the ring and taking the shorter way.
+func (list *CircularDouble[T]) walk(node *ListNode[T], steps int) *ListNode[T] {
+	steps = wrap(steps, list.len)
+
+	if steps <= list.len/2 {
+		for range steps {
+			node = node.Next
+		}
+
+		return node
+	}
+
+	for range list.len - steps {
+		node = node.Prev
+	}
+
+	return node
+}
+
+// nodeAt returns the node at a position that holds a node, walking from the nearer end.
+func (list *CircularDouble[T]) nodeAt(position int) *ListNode[T] {
+	return list.walk(list.tail, position+1)
+}
+
+// linkAfter links node into the ring right after prev. prev may only be nil if the list is empty.
+func (list *CircularDouble[T]) linkAfter(prev, node *ListNode[T]) {
+	list.len++
+
+	if prev == nil {
+		node.Next = node
+		node.Prev = node
+		list.tail = node
+		list.current = node
+
+		return
+	}
+
+	node.Prev = prev
+	node.Next = prev.Next
+	prev.Next.Prev = node
+	prev.Next = node
+}
+
+// unlink unlinks node from the ring.
+func (list *CircularDouble[T]) unlink(node *ListNode[T]) {
+	list.len--
+
+	if list.len == 0 {
+		list.ClearList()
+	} else {
+		node.Prev.Next = node.Next
+		node.Next.Prev = node.Prev
+
+		if node == list.tail {
+			list.tail = node.Prev
+		}
+
+		if node == list.current {
+			list.current = node.Next
+		}
+	}
+
+	node.Next = nil
+	node.Prev = nil
+}
@@ -0,0 +1,303 @@
+// Package linkedlist implements the Linked List Data Structure.
+package linkedlist
+
+import (
+	"fmt"
+	"iter"
+)
+
+// CircularSingle satisfies the circular linked list interface.
+var _ Circular[int] = (*CircularSingle[int])(nil)
+
+// CircularSingle represents a circular singly linked list. The tail's Next points back at
+// the head, so walking Next never reaches nil; use All to visit every node once.
+type CircularSingle[T comparable] struct {
+	tail *ListNode[T]
+	// beforeCurrent is the node before the current node. Keeping the node before it lets
+	// RemoveCurrent run in O(1) without Prev pointers.
+	beforeCurrent *ListNode[T]
+	len           int
+}
+
+// NewCircularSingleEmpty creates a new circular linked list without any nodes.
+func NewCircularSingleEmpty[T comparable]() *CircularSingle[T] {
+	return &CircularSingle[T]{}
+}
+
+// ClearList clears the Linked List.
+func (list *CircularSingle[T]) ClearList() {
+	list.tail = nil
+	list.beforeCurrent = nil
+	list.len = 0
+}
+
+// DeleteAtPosition deletes the node at the specified position and returns its data.
+func (list *CircularSingle[T]) DeleteAtPosition(position int) (T, error) {
+	if position < 0 || position >= list.len {
+		var zero T
+
+		return zero, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	prev := list.tail
+	if position > 0 {
+		prev = list.nodeAt(position - 1)
+	}
+
+	node := prev.Next
+	list.unlinkAfter(prev, node)
+
+	return node.Data, nil
+}
+
+// DeleteHead deletes the head node.
+func (list *CircularSingle[T]) DeleteHead() (T, bool) {
+	if list.IsEmpty() {
+		var zero T
+
+		return zero, false
+	}
+
+	head := list.tail.Next
+	list.unlinkAfter(list.tail, head)
+
+	return head.Data, true
+}
+
+// DeleteTail deletes the tail node. Finding the node before the tail takes O(n).
+func (list *CircularSingle[T]) DeleteTail() (T, bool) {
+	if list.IsEmpty() {
+		var zero T
+
+		return zero, false
+	}
+
+	tail := list.tail
+
+	// The only node in the ring is its own predecessor.
+	prev := tail
+	if list.len > 1 {
+		prev = list.nodeAt(list.len - 2)
+	}
+
+	list.unlinkAfter(prev, tail)
+
+	return tail.Data, true
+}
+
+// InsertAtPosition inserts a node at the specified position.
+func (list *CircularSingle[T]) InsertAtPosition(position int, data T) (bool, error) {
+	if position < 0 || position > list.len {
+		return false, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	if position == 0 {
+		return list.InsertHead(data), nil
+	}
+
+	if position == list.len {
+		return list.InsertTail(data), nil
+	}
+
+	list.linkAfter(list.nodeAt(position-1), NewListNodeWithData(data))
+
+	return true, nil
+}
+
+// InsertHead inserts a node at the head of the list.
+func (list *CircularSingle[T]) InsertHead(data T) bool {
+	list.linkAfter(list.tail, NewListNodeWithData(data))
+
+	return true
+}
+
+// InsertTail inserts a node at the tail of the list.
+func (list *CircularSingle[T]) InsertTail(data T) bool {
+	// A node linked after the tail is the new head. Moving the tail onto it makes it the tail.
+	node := NewListNodeWithData(data)
+	list.linkAfter(list.tail, node)
+	list.tail = node
+
+	return true
+}
+
+// IsEmpty returns whether the linked list is empty.
+func (list *CircularSingle[T]) IsEmpty() bool {
+	return list.Len() == 0
+}
+
+// IsValuePresent checks if a value is present in the list.
+func (list *CircularSingle[T]) IsValuePresent(data T) bool {
+	for value := range list.All() {
+		if value == data {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SetHeadIfEmpty sets the head if the list is empty.
+func (list *CircularSingle[T]) SetHeadIfEmpty(newHead *ListNode[T]) bool {
+	if list.IsEmpty() {
+		list.linkAfter(nil, newHead)
+
+		return true
+	}
+
+	return false
+}
+
+// GetHead retrieves the head node of the linked list.
+func (list *CircularSingle[T]) GetHead() *ListNode[T] {
+	if list.tail == nil {
+		return nil
+	}
+
+	return list.tail.Next
+}
+
+// GetTail retrieves the tail node of the linked list.
+func (list *CircularSingle[T]) GetTail() *ListNode[T] {
+	return list.tail
+}
+
+// Len retrieves the length of the linked list.
+func (list *CircularSingle[T]) Len() int {
+	return list.len
+}
+
+// All returns an iterator over the data in the list from head to tail, stopping after one lap.
+// The next node is read before yielding, so the current node may be removed while iterating.
+func (list *CircularSingle[T]) All() iter.Seq[T] {
+	return lap(list.GetHead, list.Len)
+}
+
+// Current returns the data at the current node. It returns false if the list is empty.
+// The current node starts at the first node inserted into an empty list.
+func (list *CircularSingle[T]) Current() (T, bool) {
+	if list.IsEmpty() {
+		var zero T
+
+		return zero, false
+	}
+
+	return list.beforeCurrent.Next.Data, true
+}
+
+// Advance moves the current node forward by steps, wrapping around the ring.
+// A negative number of steps moves it backward.
+func (list *CircularSingle[T]) Advance(steps int) {
+	for range wrap(steps, list.len) {
+		list.beforeCurrent = list.beforeCurrent.Next
+	}
+}
+
+// RemoveCurrent removes the current node and returns its data. The next node becomes current.
+// It returns false if the list is empty.
+func (list *CircularSingle[T]) RemoveCurrent() (T, bool) {
+	if list.IsEmpty() {
+		var zero T
+
+		return zero, false
+	}
+
+	current := list.beforeCurrent.Next
+	list.unlinkAfter(list.beforeCurrent, current)
+
+	return current.Data, true
+}
+
+// Rotate rotates the list left by k positions, so the node at position k becomes the head.
+// A negative k rotates the list right. The current node doesn't change.
+func (list *CircularSingle[T]) Rotate(k int) {
+	for range wrap(k, list.len) {
+		list.tail = list.tail.Next
+	}
+}
+
+// nodeAt returns the node at a position that holds a node.
+func (list *CircularSingle[T]) nodeAt(position int) *ListNode[T] {
+	current := list.tail.Next
+	for i := 0; i < position; i++ {
+		current = current.Next
+	}
+
+	return current
+}
+
+// linkAfter links node into the ring right after prev. prev may only be nil if the list is empty.
+func (list *CircularSingle[T]) linkAfter(prev, node *ListNode[T]) {
+	list.len++
+
+	if prev == nil {
+		node.Next = node
+		list.tail = node
+		list.beforeCurrent = node
+
+		return
+	}
+
+	node.Next = prev.Next
+	prev.Next = node
+
+	// Linking right before the current node must not change which node is current.
+	if prev == list.beforeCurrent {
+		list.beforeCurrent = node
+	}
+}
+
+// unlinkAfter unlinks node from the ring. prev must be the node before it.
+func (list *CircularSingle[T]) unlinkAfter(prev, node *ListNode[T]) {
+	list.len--
+
+	if list.len == 0 {
+		list.ClearList()
+		node.Next = nil
+
+		return
+	}
+
+	prev.Next = node.Next
+
+	if node == list.tail {
+		list.tail = prev
+	}
+
+	// Removing the current node makes the next one current without any bookkeeping.
+	if node == list.beforeCurrent {
+		list.beforeCurrent = prev
+	}
+
+	node.Next = nil
+}
+
+// wrap normalizes a step count to the forward steps in [0, length) reaching the same node.
+func wrap(steps, length int) int {
+	if length == 0 {
+		return 0
+	}
+
+	return (steps%length + length) % length
+}
+
+// lap returns an iterator over the data of at most length nodes, starting at the head.
+// Both are looked up when iteration starts.
+func lap[T comparable](head func() *ListNode[T], length func() int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		node := head()
+
+		for range length() {
+			if node == nil {
+				return
+			}
+
+			next := node.Next
+			if !yield(node.Data) {
+				return
+			}
+
+			node = next
+		}
+	}
+}
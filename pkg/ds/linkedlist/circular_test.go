@@ -0,0 +1,289 @@
+package linkedlist_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/linkedlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// circularList is a circular list whose internal bookkeeping can be verified.
+type circularList interface {
+	linkedlist.Circular[int]
+	CheckInvariants() error
+}
+
+// CircularTestSuite checks the ring operations of every circular list.
+type CircularTestSuite struct {
+	suite.Suite
+	newList func() circularList
+	list    circularList
+}
+
+func TestCircularSingleTestSuite(t *testing.T) {
+	suite.Run(t, &CircularTestSuite{
+		newList: func() circularList { return linkedlist.NewCircularSingleEmpty[int]() },
+	})
+}
+
+func TestCircularDoubleTestSuite(t *testing.T) {
+	suite.Run(t, &CircularTestSuite{
+		newList: func() circularList { return linkedlist.NewCircularDoubleEmpty[int]() },
+	})
+}
+
+func (suite *CircularTestSuite) SetupTest() {
+	suite.list = suite.newList()
+}
+
+func (suite *CircularTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.list.CheckInvariants())
+}
+
+// fill appends the values to the list.
+func (suite *CircularTestSuite) fill(values ...int) {
+	for _, value := range values {
+		suite.list.InsertTail(value)
+	}
+}
+
+// current returns the data at the current node, failing the test if there is none.
+func (suite *CircularTestSuite) current() int {
+	value, ok := suite.list.Current()
+	require.True(suite.T(), ok)
+
+	return value
+}
+
+func (suite *CircularTestSuite) TestTailLinksToHead() {
+	suite.fill(1, 2, 3)
+
+	assert.Equal(suite.T(), suite.list.GetHead(), suite.list.GetTail().Next)
+}
+
+func (suite *CircularTestSuite) TestEmpty() {
+	value, ok := suite.list.Current()
+	assert.False(suite.T(), ok)
+	assert.Zero(suite.T(), value)
+
+	value, ok = suite.list.RemoveCurrent()
+	assert.False(suite.T(), ok)
+	assert.Zero(suite.T(), value)
+
+	// Moving around an empty ring does nothing.
+	suite.list.Advance(3)
+	suite.list.Rotate(-2)
+	assert.Empty(suite.T(), slices.Collect(suite.list.All()))
+}
+
+func (suite *CircularTestSuite) TestAllStopsAfterOneLap() {
+	suite.fill(1, 2, 3)
+
+	assert.Equal(suite.T(), []int{1, 2, 3}, slices.Collect(suite.list.All()))
+}
+
+func (suite *CircularTestSuite) TestAllStopsEarly() {
+	suite.fill(1, 2, 3)
+
+	var visited []int
+
+	for value := range suite.list.All() {
+		visited = append(visited, value)
+		if value == 2 {
+			break
+		}
+	}
+
+	assert.Equal(suite.T(), []int{1, 2}, visited)
+}
+
+func (suite *CircularTestSuite) TestCurrentStartsAtFirstInsertedNode() {
+	suite.fill(2, 3)
+	suite.list.InsertHead(1)
+
+	assert.Equal(suite.T(), 2, suite.current())
+}
+
+func (suite *CircularTestSuite) TestAdvanceWraps() {
+	suite.fill(1, 2, 3)
+
+	suite.list.Advance(1)
+	assert.Equal(suite.T(), 2, suite.current())
+
+	suite.list.Advance(4)
+	assert.Equal(suite.T(), 3, suite.current())
+
+	suite.list.Advance(-2)
+	assert.Equal(suite.T(), 1, suite.current())
+
+	suite.list.Advance(-7)
+	assert.Equal(suite.T(), 3, suite.current())
+}
+
+func (suite *CircularTestSuite) TestRotate() {
+	suite.fill(1, 2, 3, 4)
+
+	suite.list.Rotate(1)
+	assert.Equal(suite.T(), []int{2, 3, 4, 1}, slices.Collect(suite.list.All()))
+
+	suite.list.Rotate(-3)
+	assert.Equal(suite.T(), []int{3, 4, 1, 2}, slices.Collect(suite.list.All()))
+
+	// Rotating moves the head, not the current node.
+	assert.Equal(suite.T(), 1, suite.current())
+}
+
+func (suite *CircularTestSuite) TestRemoveCurrentMovesToNext() {
+	suite.fill(1, 2, 3)
+	suite.list.Advance(2)
+
+	value, ok := suite.list.RemoveCurrent()
+	require.True(suite.T(), ok)
+	assert.Equal(suite.T(), 3, value)
+
+	// Removing the tail wraps the current node around to the head.
+	assert.Equal(suite.T(), 1, suite.current())
+	assert.Equal(suite.T(), 2, suite.list.GetTail().Data)
+	assert.Equal(suite.T(), []int{1, 2}, slices.Collect(suite.list.All()))
+}
+
+func (suite *CircularTestSuite) TestRemoveCurrentUntilEmpty() {
+	suite.fill(1, 2)
+
+	suite.list.RemoveCurrent()
+	suite.list.RemoveCurrent()
+
+	assert.True(suite.T(), suite.list.IsEmpty())
+
+	// The first node inserted into the emptied ring becomes current again.
+	suite.list.InsertTail(3)
+	assert.Equal(suite.T(), 3, suite.current())
+}
+
+func (suite *CircularTestSuite) TestInsertingKeepsCurrent() {
+	suite.fill(1, 3)
+	suite.list.Advance(1)
+
+	_, err := suite.list.InsertAtPosition(1, 2)
+	require.NoError(suite.T(), err)
+	suite.list.InsertHead(0)
+	suite.list.InsertTail(4)
+
+	assert.Equal(suite.T(), 3, suite.current())
+	assert.Equal(suite.T(), []int{0, 1, 2, 3, 4}, slices.Collect(suite.list.All()))
+}
+
+func (suite *CircularTestSuite) TestDeletingBeforeCurrentKeepsCurrent() {
+	suite.fill(1, 2, 3)
+	suite.list.Advance(2)
+
+	_, err := suite.list.DeleteAtPosition(1)
+	require.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), 3, suite.current())
+	assert.Equal(suite.T(), []int{1, 3}, slices.Collect(suite.list.All()))
+}
+
+func (suite *CircularTestSuite) TestJosephus() {
+	suite.fill(1, 2, 3, 4, 5, 6, 7)
+
+	order, err := linkedlist.Josephus[int](suite.list, 3)
+
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []int{3, 6, 2, 7, 5, 1, 4}, order)
+	assert.True(suite.T(), suite.list.IsEmpty())
+}
+
+func (suite *CircularTestSuite) TestJosephusStepOne() {
+	suite.fill(1, 2, 3)
+
+	order, err := linkedlist.Josephus[int](suite.list, 1)
+
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []int{1, 2, 3}, order)
+}
+
+func (suite *CircularTestSuite) TestJosephusInvalidStep() {
+	suite.fill(1, 2, 3)
+
+	order, err := linkedlist.Josephus[int](suite.list, 0)
+
+	assert.Nil(suite.T(), order)
+	assert.ErrorIs(suite.T(), err, linkedlist.ErrInvalidStep)
+	assert.Equal(suite.T(), 3, suite.list.Len())
+}
+
+func (suite *CircularTestSuite) TestCurrentAgainstModel() {
+	rng := rand.New(rand.NewPCG(7, 7))
+	model := []int{}
+	current := 0
+
+	for step := range modelOperations {
+		value := rng.IntN(100)
+
+		switch rng.IntN(5) {
+		case 0:
+			position := rng.IntN(len(model) + 1)
+			_, err := suite.list.InsertAtPosition(position, value)
+			require.NoError(suite.T(), err)
+
+			model = slices.Insert(model, position, value)
+			if len(model) > 1 && position <= current {
+				current++
+			}
+		case 1:
+			if len(model) == 0 {
+				break
+			}
+
+			position := rng.IntN(len(model))
+			_, err := suite.list.DeleteAtPosition(position)
+			require.NoError(suite.T(), err)
+
+			model = slices.Delete(model, position, position+1)
+			if position < current {
+				current--
+			}
+		case 2:
+			steps := rng.IntN(21) - 10
+			suite.list.Advance(steps)
+
+			if len(model) > 0 {
+				current = ((current+steps)%len(model) + len(model)) % len(model)
+			}
+		case 3:
+			actual, ok := suite.list.RemoveCurrent()
+			require.Equal(suite.T(), len(model) > 0, ok)
+
+			if ok {
+				require.Equal(suite.T(), model[current], actual)
+
+				model = slices.Delete(model, current, current+1)
+			}
+		default:
+			k := rng.IntN(21) - 10
+			suite.list.Rotate(k)
+
+			if len(model) > 0 {
+				k = (k%len(model) + len(model)) % len(model)
+				model = append(model[k:], model[:k]...)
+				current = (current - k + len(model)) % len(model)
+			}
+		}
+
+		if current >= len(model) {
+			current = 0
+		}
+
+		require.NoError(suite.T(), suite.list.CheckInvariants(), "step %d", step)
+		require.Equal(suite.T(), model, listValues(suite.list), "step %d", step)
+
+		if len(model) > 0 {
+			require.Equal(suite.T(), model[current], suite.current(), "step %d", step)
+		}
+	}
+}
@@ -0,0 +1,259 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/linkedlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// checkedList is a List whose internal bookkeeping can be verified.
+type checkedList interface {
+	linkedlist.List[int]
+	CheckInvariants() error
+}
+
+// ListConformanceTestSuite checks the behavior every List implementation must share.
+type ListConformanceTestSuite struct {
+	suite.Suite
+	newList func() checkedList
+	list    checkedList
+}
+
+func TestSingleConformance(t *testing.T) {
+	suite.Run(t, &ListConformanceTestSuite{
+		newList: func() checkedList { return linkedlist.NewSingleEmpty[int]() },
+	})
+}
+
+func TestDoubleConformance(t *testing.T) {
+	suite.Run(t, &ListConformanceTestSuite{
+		newList: func() checkedList { return linkedlist.NewDoubleEmpty[int]() },
+	})
+}
+
+func TestCircularSingleConformance(t *testing.T) {
+	suite.Run(t, &ListConformanceTestSuite{
+		newList: func() checkedList { return linkedlist.NewCircularSingleEmpty[int]() },
+	})
+}
+
+func TestCircularDoubleConformance(t *testing.T) {
+	suite.Run(t, &ListConformanceTestSuite{
+		newList: func() checkedList { return linkedlist.NewCircularDoubleEmpty[int]() },
+	})
+}
+
+func (suite *ListConformanceTestSuite) SetupTest() {
+	suite.list = suite.newList()
+}
+
+func (suite *ListConformanceTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.list.CheckInvariants())
+}
+
+// checkInvariants fails the test immediately if the list's bookkeeping is inconsistent.
+func (suite *ListConformanceTestSuite) checkInvariants() {
+	require.NoError(suite.T(), suite.list.CheckInvariants())
+}
+
+// fill appends the values to the list.
+func (suite *ListConformanceTestSuite) fill(values ...int) {
+	for _, value := range values {
+		suite.list.InsertTail(value)
+	}
+}
+
+// values returns the list's data by following the Next pointers from the head.
+func (suite *ListConformanceTestSuite) values() []int {
+	return listValues(suite.list)
+}
+
+func (suite *ListConformanceTestSuite) TestEmpty() {
+	assert.True(suite.T(), suite.list.IsEmpty())
+	assert.Equal(suite.T(), 0, suite.list.Len())
+	assert.Nil(suite.T(), suite.list.GetHead())
+	assert.Nil(suite.T(), suite.list.GetTail())
+
+	head, deleted := suite.list.DeleteHead()
+	assert.False(suite.T(), deleted)
+	assert.Zero(suite.T(), head)
+
+	tail, deleted := suite.list.DeleteTail()
+	assert.False(suite.T(), deleted)
+	assert.Zero(suite.T(), tail)
+}
+
+func (suite *ListConformanceTestSuite) TestInsertHeadAndTail() {
+	assert.True(suite.T(), suite.list.InsertTail(2))
+	assert.True(suite.T(), suite.list.InsertHead(1))
+	assert.True(suite.T(), suite.list.InsertTail(3))
+
+	assert.Equal(suite.T(), []int{1, 2, 3}, suite.values())
+	assert.Equal(suite.T(), 1, suite.list.GetHead().Data)
+	assert.Equal(suite.T(), 3, suite.list.GetTail().Data)
+	assert.Equal(suite.T(), 3, suite.list.Len())
+}
+
+func (suite *ListConformanceTestSuite) TestInsertAtPosition() {
+	suite.fill(1, 2, 4)
+
+	inserted, err := suite.list.InsertAtPosition(2, 3)
+
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), inserted)
+	assert.Equal(suite.T(), []int{1, 2, 3, 4}, suite.values())
+	assert.Equal(suite.T(), 4, suite.list.Len())
+}
+
+func (suite *ListConformanceTestSuite) TestInsertAtPositionEmpty() {
+	inserted, err := suite.list.InsertAtPosition(0, 7)
+
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), inserted)
+	assert.Equal(suite.T(), []int{7}, suite.values())
+	assert.Equal(suite.T(), 7, suite.list.GetTail().Data)
+}
+
+func (suite *ListConformanceTestSuite) TestInsertAtInvalidPosition() {
+	suite.fill(1, 2)
+
+	for _, position := range []int{-1, 3} {
+		inserted, err := suite.list.InsertAtPosition(position, 9)
+
+		assert.False(suite.T(), inserted)
+		assert.ErrorIs(suite.T(), err, linkedlist.ErrInvalidPosition)
+	}
+
+	assert.Equal(suite.T(), []int{1, 2}, suite.values())
+}
+
+func (suite *ListConformanceTestSuite) TestDeleteAtPosition() {
+	suite.fill(1, 2, 3, 4, 5)
+
+	value, err := suite.list.DeleteAtPosition(2)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, value)
+
+	value, err = suite.list.DeleteAtPosition(3)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 5, value)
+
+	value, err = suite.list.DeleteAtPosition(0)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, value)
+
+	assert.Equal(suite.T(), []int{2, 4}, suite.values())
+	assert.Equal(suite.T(), 2, suite.list.GetHead().Data)
+	assert.Equal(suite.T(), 4, suite.list.GetTail().Data)
+	assert.Equal(suite.T(), 2, suite.list.Len())
+}
+
+func (suite *ListConformanceTestSuite) TestDeleteAtInvalidPosition() {
+	suite.fill(1, 2)
+
+	for _, position := range []int{-1, 2, 5} {
+		value, err := suite.list.DeleteAtPosition(position)
+
+		assert.Zero(suite.T(), value)
+		assert.ErrorIs(suite.T(), err, linkedlist.ErrInvalidPosition)
+	}
+
+	assert.Equal(suite.T(), 2, suite.list.Len())
+}
+
+func (suite *ListConformanceTestSuite) TestDeleteHeadAndTail() {
+	suite.fill(1, 2, 3)
+
+	head, deleted := suite.list.DeleteHead()
+	assert.True(suite.T(), deleted)
+	assert.Equal(suite.T(), 1, head)
+
+	tail, deleted := suite.list.DeleteTail()
+	assert.True(suite.T(), deleted)
+	assert.Equal(suite.T(), 3, tail)
+
+	assert.Equal(suite.T(), []int{2}, suite.values())
+	assert.Equal(suite.T(), 1, suite.list.Len())
+}
+
+func (suite *ListConformanceTestSuite) TestIsValuePresent() {
+	suite.fill(1, 2, 3)
+
+	assert.True(suite.T(), suite.list.IsValuePresent(2))
+	assert.False(suite.T(), suite.list.IsValuePresent(4))
+}
+
+func (suite *ListConformanceTestSuite) TestClearList() {
+	suite.fill(1, 2, 3)
+	suite.list.ClearList()
+
+	assert.True(suite.T(), suite.list.IsEmpty())
+	assert.Nil(suite.T(), suite.list.GetHead())
+	assert.Nil(suite.T(), suite.list.GetTail())
+	assert.Empty(suite.T(), suite.values())
+}
+
+func (suite *ListConformanceTestSuite) TestSetHeadIfEmpty() {
+	assert.True(suite.T(), suite.list.SetHeadIfEmpty(linkedlist.NewListNodeWithData(1)))
+	assert.False(suite.T(), suite.list.SetHeadIfEmpty(linkedlist.NewListNodeWithData(2)))
+
+	assert.Equal(suite.T(), []int{1}, suite.values())
+	assert.Equal(suite.T(), 1, suite.list.Len())
+}
+
+func (suite *ListConformanceTestSuite) TestInsertAtPositionZero() {
+	suite.fill(2, 3)
+
+	inserted, err := suite.list.InsertAtPosition(0, 1)
+
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), inserted)
+	suite.checkInvariants()
+	assert.Equal(suite.T(), []int{1, 2, 3}, suite.values())
+	assert.Equal(suite.T(), 1, suite.list.GetHead().Data)
+}
+
+func (suite *ListConformanceTestSuite) TestInsertAtPositionEnd() {
+	suite.fill(1, 2)
+
+	inserted, err := suite.list.InsertAtPosition(2, 3)
+
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), inserted)
+	suite.checkInvariants()
+	assert.Equal(suite.T(), []int{1, 2, 3}, suite.values())
+	assert.Equal(suite.T(), 3, suite.list.GetTail().Data)
+}
+
+func (suite *ListConformanceTestSuite) TestDeleteHeadUntilEmpty() {
+	suite.fill(1, 2)
+
+	suite.list.DeleteHead()
+	suite.checkInvariants()
+	assert.Equal(suite.T(), 2, suite.list.GetTail().Data)
+
+	suite.list.DeleteHead()
+	suite.checkInvariants()
+	assert.Nil(suite.T(), suite.list.GetHead())
+	assert.Nil(suite.T(), suite.list.GetTail())
+
+	// The list must be usable again once it is empty.
+	suite.list.InsertTail(3)
+	suite.checkInvariants()
+	assert.Equal(suite.T(), []int{3}, suite.values())
+}
+
+func (suite *ListConformanceTestSuite) TestDeleteTailMovesTail() {
+	suite.fill(1, 2, 3)
+
+	suite.list.DeleteTail()
+	suite.checkInvariants()
+	assert.Equal(suite.T(), 2, suite.list.GetTail().Data)
+
+	suite.list.InsertTail(4)
+	suite.checkInvariants()
+	assert.Equal(suite.T(), []int{1, 2, 4}, suite.values())
+}
@@ -0,0 +1,103 @@
+// Package linkedlist implements the Linked List Data Structure.
+package linkedlist
+
+// linker is implemented by the lists a Cursor can edit. Both operations run in O(1).
+type linker[T comparable] interface {
+	linkAfter(prev, node *ListNode[T])
+	unlinkAfter(prev, node *ListNode[T])
+}
+
+// Cursor points at a node in a Single or Double list and inserts or removes nodes around
+// it in O(1), without looking positions up again.
+// A cursor that has moved past the tail points at no node. It can still insert before
+// its position, which appends to the list.
+// Modifying the list other than through the cursor invalidates it.
+type Cursor[T comparable] struct {
+	list linker[T]
+	prev *ListNode[T] // The node before the cursor, or nil at the head.
+	node *ListNode[T] // The node at the cursor, or nil past the tail.
+}
+
+// Cursor returns a cursor pointing at the head of the list.
+func (list *Single[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{list: list, node: list.Head}
+}
+
+// Cursor returns a cursor pointing at the head of the list.
+func (list *Double[T]) Cursor() *Cursor[T] {
+	return &Cursor[T]{list: list, node: list.Head}
+}
+
+// Valid returns true if the cursor points at a node.
+func (c *Cursor[T]) Valid() bool {
+	return c.node != nil
+}
+
+// Value returns the data at the cursor. It returns false if the cursor doesn't point at a node.
+func (c *Cursor[T]) Value() (T, bool) {
+	if c.node == nil {
+		var zero T
+
+		return zero, false
+	}
+
+	return c.node.Data, true
+}
+
+// Set replaces the data at the cursor. It returns false if the cursor doesn't point at a node.
+func (c *Cursor[T]) Set(data T) bool {
+	if c.node == nil {
+		return false
+	}
+
+	c.node.Data = data
+
+	return true
+}
+
+// Next moves the cursor to the next node. It returns false once the cursor has moved past the tail.
+func (c *Cursor[T]) Next() bool {
+	if c.node == nil {
+		return false
+	}
+
+	c.prev = c.node
+	c.node = c.node.Next
+
+	return c.node != nil
+}
+
+// InsertBefore inserts the data before the cursor. The cursor keeps pointing at the same node.
+func (c *Cursor[T]) InsertBefore(data T) {
+	newNode := NewListNodeWithData(data)
+	c.list.linkAfter(c.prev, newNode)
+	c.prev = newNode
+}
+
+// InsertAfter inserts the data after the cursor. The cursor keeps pointing at the same node.
+// It returns false if the cursor doesn't point at a node.
+func (c *Cursor[T]) InsertAfter(data T) bool {
+	if c.node == nil {
+		return false
+	}
+
+	c.list.linkAfter(c.node, NewListNodeWithData(data))
+
+	return true
+}
+
+// Remove removes the node at the cursor and returns its data. The cursor moves to the next node.
+// It returns false if the cursor doesn't point at a node.
+func (c *Cursor[T]) Remove() (T, bool) {
+	if c.node == nil {
+		var zero T
+
+		return zero, false
+	}
+
+	removed := c.node
+	c.node = removed.Next
+	c.list.unlinkAfter(c.prev, removed)
+
+	return removed.Data, true
+}
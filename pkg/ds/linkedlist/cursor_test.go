@@ -0,0 +1,189 @@
+package linkedlist_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/linkedlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// cursorList is a checked list that can hand out cursors.
+type cursorList interface {
+	checkedList
+	Cursor() *linkedlist.Cursor[int]
+}
+
+type CursorTestSuite struct {
+	suite.Suite
+	newList func() cursorList
+	list    cursorList
+}
+
+func TestSingleCursorTestSuite(t *testing.T) {
+	suite.Run(t, &CursorTestSuite{
+		newList: func() cursorList { return linkedlist.NewSingleEmpty[int]() },
+	})
+}
+
+func TestDoubleCursorTestSuite(t *testing.T) {
+	suite.Run(t, &CursorTestSuite{
+		newList: func() cursorList { return linkedlist.NewDoubleEmpty[int]() },
+	})
+}
+
+func (suite *CursorTestSuite) SetupTest() {
+	suite.list = suite.newList()
+
+	for i := 1; i <= 3; i++ {
+		suite.list.InsertTail(i)
+	}
+}
+
+func (suite *CursorTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.list.CheckInvariants())
+}
+
+func (suite *CursorTestSuite) TestWalk() {
+	cursor := suite.list.Cursor()
+	values := []int{}
+
+	for ; cursor.Valid(); cursor.Next() {
+		value, ok := cursor.Value()
+		require.True(suite.T(), ok)
+
+		values = append(values, value)
+	}
+
+	assert.Equal(suite.T(), []int{1, 2, 3}, values)
+	assert.False(suite.T(), cursor.Next())
+
+	value, ok := cursor.Value()
+
+	assert.False(suite.T(), ok)
+	assert.Zero(suite.T(), value)
+}
+
+func (suite *CursorTestSuite) TestSet() {
+	cursor := suite.list.Cursor()
+	cursor.Next()
+
+	assert.True(suite.T(), cursor.Set(20))
+	assert.Equal(suite.T(), []int{1, 20, 3}, listValues(suite.list))
+}
+
+func (suite *CursorTestSuite) TestInsertBefore() {
+	cursor := suite.list.Cursor()
+	cursor.InsertBefore(0)
+	cursor.Next()
+	cursor.InsertBefore(15)
+
+	value, _ := cursor.Value()
+
+	assert.Equal(suite.T(), 2, value)
+	assert.Equal(suite.T(), []int{0, 1, 15, 2, 3}, listValues(suite.list))
+	assert.Equal(suite.T(), 0, suite.list.GetHead().Data)
+}
+
+func (suite *CursorTestSuite) TestInsertBeforePastTail() {
+	cursor := suite.list.Cursor()
+	for cursor.Valid() {
+		cursor.Next()
+	}
+
+	cursor.InsertBefore(4)
+	cursor.InsertBefore(5)
+
+	assert.Equal(suite.T(), []int{1, 2, 3, 4, 5}, listValues(suite.list))
+	assert.Equal(suite.T(), 5, suite.list.GetTail().Data)
+}
+
+func (suite *CursorTestSuite) TestInsertAfter() {
+	cursor := suite.list.Cursor()
+	cursor.Next()
+	cursor.Next()
+
+	assert.True(suite.T(), cursor.InsertAfter(4))
+	assert.Equal(suite.T(), 4, suite.list.GetTail().Data)
+
+	cursor.Next()
+	cursor.Next()
+
+	assert.False(suite.T(), cursor.InsertAfter(5))
+	assert.Equal(suite.T(), []int{1, 2, 3, 4}, listValues(suite.list))
+}
+
+func (suite *CursorTestSuite) TestRemove() {
+	cursor := suite.list.Cursor()
+
+	value, removed := cursor.Remove()
+	assert.True(suite.T(), removed)
+	assert.Equal(suite.T(), 1, value)
+
+	cursor.Next()
+
+	value, removed = cursor.Remove()
+	assert.True(suite.T(), removed)
+	assert.Equal(suite.T(), 3, value)
+
+	_, removed = cursor.Remove()
+	assert.False(suite.T(), removed)
+
+	assert.Equal(suite.T(), []int{2}, listValues(suite.list))
+	assert.Equal(suite.T(), 2, suite.list.GetTail().Data)
+}
+
+func (suite *CursorTestSuite) TestRemoveEverything() {
+	cursor := suite.list.Cursor()
+	for cursor.Valid() {
+		cursor.Remove()
+	}
+
+	assert.True(suite.T(), suite.list.IsEmpty())
+
+	cursor.InsertBefore(9)
+
+	assert.Equal(suite.T(), []int{9}, listValues(suite.list))
+}
+
+func (suite *CursorTestSuite) TestRandomEdits() {
+	rng := rand.New(rand.NewPCG(7, 7))
+	model := listValues(suite.list)
+	// position is where the cursor points in the model.
+	position := 0
+	cursor := suite.list.Cursor()
+
+	for range 3000 {
+		value := rng.IntN(100)
+
+		switch rng.IntN(5) {
+		case 0:
+			cursor.InsertBefore(value)
+			model = slices.Insert(model, position, value)
+			position++
+		case 1:
+			if cursor.InsertAfter(value) {
+				model = slices.Insert(model, position+1, value)
+			}
+		case 2:
+			if _, removed := cursor.Remove(); removed {
+				model = slices.Delete(model, position, position+1)
+			}
+		case 3:
+			if cursor.Valid() {
+				cursor.Next()
+				position++
+			}
+		default:
+			// Restart from the head now and then.
+			cursor = suite.list.Cursor()
+			position = 0
+		}
+
+		require.NoError(suite.T(), suite.list.CheckInvariants())
+		require.Equal(suite.T(), model, listValues(suite.list))
+	}
+}
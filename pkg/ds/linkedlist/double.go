@@ -3,10 +3,40 @@ package linkedlist
 
 import "fmt"
 
+// Double satisfies the linked list interfaces.
+var _ List[int] = (*Double[int])(nil)
+
 // Double represents a doubly linked list.
 type Double[T comparable] struct {
 	Head, Tail *ListNode[T]
 	len        int
+	// id identifies the list in the nodes it owns. It is created on the first insertion
+	// and replaced by ClearList, which disowns every node at once.
+	id *listID
+}
+
+// listID identifies a Double list in the nodes it owns.
+type listID struct {
+	// merged points at the id of the list this list's nodes were moved into, if any.
+	// Forwarding ids lets Concat and Splice hand nodes to another list in O(1).
+	merged *listID
+}
+
+// resolve follows the merged ids to the id that currently owns the nodes, compressing
+// the path so later lookups are fast.
+func (id *listID) resolve() *listID {
+	root := id
+	for root.merged != nil {
+		root = root.merged
+	}
+
+	for id != root {
+		next := id.merged
+		id.merged = root
+		id = next
+	}
+
+	return root
 }
 
 // NewDoubleEmpty creates a new linked list without a head or tail.
@@ -16,13 +46,10 @@ func NewDoubleEmpty[T comparable]() *Double[T] {
 
 // NewDoubleWithHead creates a new linked list with the head element set.
 func NewDoubleWithHead[T comparable](data T) *Double[T] {
-	head := NewListNodeWithData[T](data)
+	list := NewDoubleEmpty[T]()
+	list.InsertHead(data)
 
-	return &Double[T]{
-		Head: head,
-		Tail: head,
-		len:  1,
-	}
+	return list
 }
 
 // ClearList clears the Linked List.
@@ -30,93 +57,49 @@ func (list *Double[T]) ClearList() {
 	list.Head = nil
 	list.Tail = nil
 	list.len = 0
+	list.id = nil
 }
 
-// DeleteAtPosition deletes the node at the specified position.
-func (list *Double[T]) DeleteAtPosition(position int) (any, bool, error) {
-	if list.isInvalidPosition(position) {
-		return nil, false, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
-	}
-
-	if list.IsEmpty() {
-		return 0, false, nil
-	}
-
-	var value any
-	if position == 0 {
-		value = list.Head.Data
-		list.Head = list.Head.Next
-
-		if list.Head != nil {
-			list.Head.Prev = nil
-		} else {
-			list.Tail = nil
-		}
-
-		list.len--
+// DeleteAtPosition deletes the node at the specified position and returns its data.
+func (list *Double[T]) DeleteAtPosition(position int) (T, error) {
+	if list.isOutOfRange(position) {
+		var zero T
 
-		return value, true, nil
+		return zero, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
 	}
 
-	current := list.Head
-	for i := 0; i < position-1; i++ {
-		current = current.Next
-	}
-
-	value = current.Next.Data
-	current.Next = current.Next.Next
-
-	if current.Next != nil {
-		current.Next.Prev = current
-	} else {
-		list.Tail = current
-	}
+	current := list.nodeAt(position)
+	list.unlinkAfter(current.Prev, current)
 
-	list.len--
-
-	return value, true, nil
+	return current.Data, nil
 }
 
 // DeleteHead deletes the head node.
-func (list *Double[T]) DeleteHead() (any, bool) {
+func (list *Double[T]) DeleteHead() (T, bool) {
 	if list.IsEmpty() {
-		return 0, false
-	}
+		var zero T
 
-	value := list.Head.Data
-	list.Head = list.Head.Next
-
-	if list.Head != nil {
-		list.Head.Prev = nil
-	} else {
-		list.Tail = nil
+		return zero, false
 	}
 
-	list.len--
+	head := list.Head
+	list.unlinkAfter(nil, head)
 
-	return value, true
+	return head.Data, true
 }
 
 // DeleteTail deletes the tail node.
-func (list *Double[T]) DeleteTail() (any, bool) {
+func (list *Double[T]) DeleteTail() (T, bool) {
 	if list.IsEmpty() {
-		return 0, false
-	}
-
-	if list.len == 1 {
-		value := list.Head.Data
-		list.ClearList()
+		var zero T
 
-		return value, true
+		return zero, false
 	}
 
-	value := list.Tail.Data
-	current := list.Tail.Prev
-	current.Next = nil
-	list.Tail = current
-	list.len--
+	tail := list.Tail
+	list.unlinkAfter(tail.Prev, tail)
 
-	return value, true
+	return tail.Data, true
 }
 
 // IsEmpty returns whether the linked list is empty.
@@ -124,61 +107,60 @@ func (list *Double[T]) IsEmpty() bool {
 	return list.Len() == 0
 }
 
-// isInvalidPosition checks to see if a list position is invalid.
+// isInvalidPosition checks to see if a list position is invalid for inserting a node.
 func (list *Double[T]) isInvalidPosition(position int) bool {
 	return position < 0 || position > list.Len()
 }
 
+// isOutOfRange checks to see if a list position doesn't hold a node.
+func (list *Double[T]) isOutOfRange(position int) bool {
+	return position < 0 || position >= list.Len()
+}
+
+// nodeAt returns the node at a position that holds a node, walking from the nearer end.
+func (list *Double[T]) nodeAt(position int) *ListNode[T] {
+	if position < list.len/2 {
+		current := list.Head
+		for i := 0; i < position; i++ {
+			current = current.Next
+		}
+
+		return current
+	}
+
+	current := list.Tail
+	for i := list.len - 1; i > position; i-- {
+		current = current.Prev
+	}
+
+	return current
+}
+
 // InsertAtPosition inserts a node at the specified position.
 func (list *Double[T]) InsertAtPosition(position int, data T) (bool, error) {
 	if list.isInvalidPosition(position) {
 		return false, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
 	}
 
-	newNode := NewListNodeWithData(data)
-	if list.SetHeadIfEmpty(newNode) {
-		return true, nil
-	}
-
-	current := list.Head
-	for i := 0; i < position-1; i++ {
-		current = current.Next
+	if position == 0 {
+		return list.InsertHead(data), nil
 	}
 
-	newNode.Next = current.Next
-	newNode.Prev = current
-	current.Next = newNode
-	list.len++
+	list.linkAfter(list.nodeAt(position-1), NewListNodeWithData(data))
 
 	return true, nil
 }
 
 // InsertHead inserts a node at the head of the list.
 func (list *Double[T]) InsertHead(data T) bool {
-	newNode := NewListNodeWithData(data)
-	if list.SetHeadIfEmpty(newNode) {
-		return true
-	}
-
-	newNode.Next = list.Head
-	list.Head.Prev = newNode
-	list.Head = newNode
-	list.len++
+	list.linkAfter(nil, NewListNodeWithData(data))
 
 	return true
 }
 
 // InsertTail inserts a node at the tail of the list.
 func (list *Double[T]) InsertTail(data T) bool {
-	newNode := NewListNodeWithData(data)
-	if list.SetHeadIfEmpty(newNode) {
-		return true
-	}
-
-	list.Tail.Next = newNode
-	newNode.Prev = list.Tail
-	list.Tail = newNode
-	list.len++
+	list.linkAfter(list.Tail, NewListNodeWithData(data))
 
 	return true
 }
@@ -203,9 +185,7 @@ func (list *Double[T]) IsValuePresent(data T) bool {
 // SetHeadIfEmpty sets the head if the list is empty or position is invalid.
 func (list *Double[T]) SetHeadIfEmpty(newHead *ListNode[T]) bool {
 	if list.IsEmpty() {
-		list.Head = newHead
-		list.Tail = newHead
-		list.len++
+		list.linkAfter(nil, newHead)
 
 		return true
 	}
@@ -227,3 +207,54 @@ func (list *Double[T]) GetTail() *ListNode[T] {
 func (list *Double[T]) Len() int {
 	return list.len
 }
+
+// identity returns the list's id, creating it if needed.
+func (list *Double[T]) identity() *listID {
+	if list.id == nil {
+		list.id = &listID{}
+	}
+
+	return list.id
+}
+
+// linkAfter links node into the list right after prev, or at the head if prev is nil.
+func (list *Double[T]) linkAfter(prev, node *ListNode[T]) {
+	node.owner = list.identity()
+	node.Prev = prev
+
+	if prev == nil {
+		node.Next = list.Head
+		list.Head = node
+	} else {
+		node.Next = prev.Next
+		prev.Next = node
+	}
+
+	if node.Next == nil {
+		list.Tail = node
+	} else {
+		node.Next.Prev = node
+	}
+
+	list.len++
+}
+
+// unlinkAfter unlinks node from the list. prev must be the node before it, or nil if node is the head.
+func (list *Double[T]) unlinkAfter(prev, node *ListNode[T]) {
+	if prev == nil {
+		list.Head = node.Next
+	} else {
+		prev.Next = node.Next
+	}
+
+	if node.Next == nil {
+		list.Tail = prev
+	} else {
+		node.Next.Prev = prev
+	}
+
+	node.Next = nil
+	node.Prev = nil
+	node.owner = nil
+	list.len--
+}
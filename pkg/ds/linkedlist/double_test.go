@@ -248,10 +248,9 @@ func (suite *DoubleLinkedListTestSuite) TestEmptyListDeleteAtInvalidPosition() {
 	position := 5
 	errMsg := fmt.Errorf("%v: %d", linkedlist.ErrInvalidPosition, position)
 	suite.doubleList = linkedlist.NewDoubleEmpty[int]()
-	actualValue, deleted, err := suite.doubleList.DeleteAtPosition(position)
+	actualValue, err := suite.doubleList.DeleteAtPosition(position)
 	assert.Equal(suite.T(), expectedLen, suite.doubleList.Len())
-	assert.Nil(suite.T(), actualValue)
-	assert.False(suite.T(), deleted)
+	assert.Zero(suite.T(), actualValue)
 	assert.ErrorIs(suite.T(), err, linkedlist.ErrInvalidPosition, errMsg)
 }
 
@@ -265,11 +264,10 @@ func (suite *DoubleLinkedListTestSuite) TestEmptyListDeleteAtFirstPosition() {
 	suite.doubleList.InsertHead(4)
 	suite.doubleList.InsertHead(5)
 	suite.doubleList.InsertHead(expectedValue)
-	actualValue, deleted, err := suite.doubleList.DeleteAtPosition(position)
+	actualValue, err := suite.doubleList.DeleteAtPosition(position)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), expectedLen, suite.doubleList.Len())
 	assert.Equal(suite.T(), expectedValue, actualValue)
-	assert.True(suite.T(), deleted)
 }
 
 func (suite *DoubleLinkedListTestSuite) TestEmptyListDeleteAtNFirstPosition() {
@@ -282,11 +280,10 @@ func (suite *DoubleLinkedListTestSuite) TestEmptyListDeleteAtNFirstPosition() {
 	suite.doubleList.InsertHead(4)
 	suite.doubleList.InsertHead(5)
 	suite.doubleList.InsertHead(6)
-	actualValue, deleted, err := suite.doubleList.DeleteAtPosition(position)
+	actualValue, err := suite.doubleList.DeleteAtPosition(position)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), expectedLen, suite.doubleList.Len())
 	assert.Equal(suite.T(), expectedValue, actualValue)
-	assert.True(suite.T(), deleted)
 }
 
 func (suite *DoubleLinkedListTestSuite) TestEmptyListIsEmpty() {
@@ -0,0 +1,241 @@
+package linkedlist
+
+import (
+	"errors"
+	"fmt"
+)
+
+// errInvariant is an error indicating a list's internal bookkeeping is inconsistent.
+var errInvariant = errors.New("invariant violated")
+
+// CheckInvariants exposes checkInvariants to the linkedlist_test package.
+func (list *Single[T]) CheckInvariants() error {
+	return list.checkInvariants()
+}
+
+// CheckInvariants exposes checkInvariants to the linkedlist_test package.
+func (list *Double[T]) CheckInvariants() error {
+	return list.checkInvariants()
+}
+
+// CheckInvariants exposes checkInvariants to the linkedlist_test package.
+func (list *CircularSingle[T]) CheckInvariants() error {
+	return list.checkInvariants()
+}
+
+// CheckInvariants exposes checkInvariants to the linkedlist_test package.
+func (list *CircularDouble[T]) CheckInvariants() error {
+	return list.checkInvariants()
+}
+
+// checkInvariants verifies len, Head/Tail reachability and that no node has a Prev pointer or owner.
+func (list *Single[T]) checkInvariants() error {
+	return checkNodes(list.Head, list.Tail, list.len, false, nil)
+}
+
+// checkInvariants verifies len, Head/Tail reachability, Prev/Next symmetry and node ownership.
+func (list *Double[T]) checkInvariants() error {
+	if list.len > 0 && list.id == nil {
+		return fmt.Errorf("%w: non-empty list has no id", errInvariant)
+	}
+
+	return checkNodes(list.Head, list.Tail, list.len, true, list.id)
+}
+
+// checkNodes walks the nodes from head and verifies they match tail and length. When doubly
+// is true every node's Prev must point at the node before it, otherwise Prev must be nil.
+// Every node must be owned by owner.
+func checkNodes[T comparable](head, tail *ListNode[T], length int, doubly bool, owner *listID) error {
+	if length == 0 {
+		if head != nil || tail != nil {
+			return fmt.Errorf("%w: empty list has head %p and tail %p", errInvariant, head, tail)
+		}
+
+		return nil
+	}
+
+	if head == nil || tail == nil {
+		return fmt.Errorf("%w: list of len %d has head %p and tail %p", errInvariant, length, head, tail)
+	}
+
+	count := 0
+
+	var prev *ListNode[T]
+
+	for node := head; node != nil; node = node.Next {
+		count++
+
+		// Stop early so a cycle can't loop forever.
+		if count > length {
+			return fmt.Errorf("%w: more than len %d nodes are reachable from head", errInvariant, length)
+		}
+
+		expectedPrev := prev
+		if !doubly {
+			expectedPrev = nil
+		}
+
+		if node.Prev != expectedPrev {
+			return fmt.Errorf("%w: node %d has Prev %p, expected %p", errInvariant, count-1, node.Prev, expectedPrev)
+		}
+
+		if (owner == nil && node.owner != nil) || (owner != nil && (node.owner == nil || node.owner.resolve() != owner)) {
+			return fmt.Errorf("%w: node %d is owned by %p, expected %p", errInvariant, count-1, node.owner, owner)
+		}
+
+		prev = node
+	}
+
+	if count != length {
+		return fmt.Errorf("%w: %d nodes are reachable from head, len is %d", errInvariant, count, length)
+	}
+
+	if prev != tail {
+		return fmt.Errorf("%w: the last reachable node %p isn't the tail %p", errInvariant, prev, tail)
+	}
+
+	return nil
+}
+
+// checkInvariants verifies the ring closes after len nodes, has no Prev pointers and that the
+// node before the current one is in the ring.
+func (list *CircularSingle[T]) checkInvariants() error {
+	return checkRing(list.tail, list.beforeCurrent, list.len, false)
+}
+
+// checkInvariants verifies the ring closes after len nodes in both directions and that the
+// current node is in the ring.
+func (list *CircularDouble[T]) checkInvariants() error {
+	return checkRing(list.tail, list.current, list.len, true)
+}
+
+// checkRing walks the ring from the node after tail and verifies it returns there after
+// exactly length nodes, ending at tail, and that member is one of the nodes. When doubly is
+// true every node's Prev must point at the node before it, otherwise Prev must be nil.
+func checkRing[T comparable](tail, member *ListNode[T], length int, doubly bool) error {
+	if length == 0 {
+		if tail != nil || member != nil {
+			return fmt.Errorf("%w: empty ring has tail %p and member %p", errInvariant, tail, member)
+		}
+
+		return nil
+	}
+
+	if tail == nil || tail.Next == nil {
+		return fmt.Errorf("%w: ring of len %d has tail %p", errInvariant, length, tail)
+	}
+
+	head := tail.Next
+	prev := tail
+	found := false
+	node := head
+
+	for count := range length {
+		if node == nil || (count > 0 && node == head) {
+			return fmt.Errorf("%w: ring breaks or closes after %d of len %d nodes", errInvariant, count, length)
+		}
+
+		expectedPrev := prev
+		if !doubly {
+			expectedPrev = nil
+		}
+
+		if node.Prev != expectedPrev {
+			return fmt.Errorf("%w: node %d has Prev %p, expected %p", errInvariant, count, node.Prev, expectedPrev)
+		}
+
+		if node.owner != nil {
+			return fmt.Errorf("%w: node %d is owned by %p", errInvariant, count, node.owner)
+		}
+
+		found = found || node == member
+		prev = node
+		node = node.Next
+	}
+
+	if node != head || prev != tail {
+		return fmt.Errorf("%w: the ring doesn't close at the tail after len %d nodes", errInvariant, length)
+	}
+
+	if !found {
+		return fmt.Errorf("%w: member %p isn't in the ring", errInvariant, member)
+	}
+
+	return nil
+}
+
+// CheckInvariants exposes checkInvariants to the linkedlist_test package.
+func (list *Unrolled[T]) CheckInvariants() error {
+	return list.checkInvariants()
+}
+
+// NodeCapacity returns the most elements a node of the list holds.
+func (list *Unrolled[T]) NodeCapacity() int {
+	return list.capacity
+}
+
+// checkInvariants verifies the nodes link both ways from head to tail, hold len elements in
+// total, are never empty or over capacity, and that every node but the head and tail is at
+// least half full. An adaptive list's capacity must also be within range of its length.
+func (list *Unrolled[T]) checkInvariants() error {
+	if (list.head == nil) != (list.tail == nil) {
+		return fmt.Errorf("%w: head %p and tail %p", errInvariant, list.head, list.tail)
+	}
+
+	squared := list.capacity * list.capacity
+	if list.adaptive && (list.len > squared || (list.capacity > DefaultNodeCapacity && list.len < squared/16)) {
+		return fmt.Errorf("%w: capacity %d is out of range for len %d", errInvariant, list.capacity, list.len)
+	}
+
+	total := 0
+
+	var prev *unrolledNode[T]
+
+	for node := list.head; node != nil; node = node.next {
+		if node.prev != prev {
+			return fmt.Errorf("%w: node after %p has prev %p", errInvariant, prev, node.prev)
+		}
+
+		size := len(node.items)
+		if size == 0 || size > list.capacity || cap(node.items) != list.capacity {
+			return fmt.Errorf("%w: node holds %d of %d elements with cap %d",
+				errInvariant, size, list.capacity, cap(node.items))
+		}
+
+		if node != list.head && node != list.tail && size < list.capacity/2 {
+			return fmt.Errorf("%w: inner node is less than half full with %d elements", errInvariant, size)
+		}
+
+		total += size
+
+		// Stop early so a cycle can't loop forever.
+		if total > list.len {
+			return fmt.Errorf("%w: more than len %d elements are reachable from head", errInvariant, list.len)
+		}
+
+		prev = node
+	}
+
+	if total != list.len {
+		return fmt.Errorf("%w: %d elements are reachable from head, len is %d", errInvariant, total, list.len)
+	}
+
+	if prev != list.tail {
+		return fmt.Errorf("%w: the last reachable node %p isn't the tail %p", errInvariant, prev, list.tail)
+	}
+
+	return nil
+}
+
+// CheckInvariants checks the invariants of the list Synchronized wraps, under its lock.
+func (s *Synchronized[T]) CheckInvariants() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	checked, ok := s.list.(interface{ checkInvariants() error })
+	if !ok {
+		return fmt.Errorf("%w: %T can't check its invariants", errInvariant, s.list)
+	}
+
+	return checked.checkInvariants()
+}
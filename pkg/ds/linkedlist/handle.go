@@ -0,0 +1,100 @@
+// Package linkedlist implements the Linked List Data Structure.
+package linkedlist
+
+// The node-handle API lets callers keep a *ListNode[T] and edit the list around it in O(1),
+// which is what LRU caches and schedulers need. Every node records the list that owns it,
+// so a node from another list, or one that has already been removed, is rejected with
+// ErrNodeNotInList instead of corrupting either list.
+
+// owns returns true if the node currently belongs to the list.
+func (list *Double[T]) owns(node *ListNode[T]) bool {
+	if node == nil || node.owner == nil || list.id == nil {
+		return false
+	}
+
+	// Point the node straight at its current owner so the next lookup is quick.
+	node.owner = node.owner.resolve()
+
+	return node.owner == list.id
+}
+
+// InsertHeadNode inserts the data at the head of the list and returns its node.
+func (list *Double[T]) InsertHeadNode(data T) *ListNode[T] {
+	newNode := NewListNodeWithData(data)
+	list.linkAfter(nil, newNode)
+
+	return newNode
+}
+
+// InsertTailNode inserts the data at the tail of the list and returns its node.
+func (list *Double[T]) InsertTailNode(data T) *ListNode[T] {
+	newNode := NewListNodeWithData(data)
+	list.linkAfter(list.Tail, newNode)
+
+	return newNode
+}
+
+// InsertBefore inserts the data right before mark and returns its node.
+func (list *Double[T]) InsertBefore(mark *ListNode[T], data T) (*ListNode[T], error) {
+	if !list.owns(mark) {
+		return nil, ErrNodeNotInList
+	}
+
+	newNode := NewListNodeWithData(data)
+	list.linkAfter(mark.Prev, newNode)
+
+	return newNode, nil
+}
+
+// InsertAfter inserts the data right after mark and returns its node.
+func (list *Double[T]) InsertAfter(mark *ListNode[T], data T) (*ListNode[T], error) {
+	if !list.owns(mark) {
+		return nil, ErrNodeNotInList
+	}
+
+	newNode := NewListNodeWithData(data)
+	list.linkAfter(mark, newNode)
+
+	return newNode, nil
+}
+
+// Remove removes the node from the list and returns its data.
+func (list *Double[T]) Remove(node *ListNode[T]) (T, error) {
+	if !list.owns(node) {
+		var zero T
+
+		return zero, ErrNodeNotInList
+	}
+
+	list.unlinkAfter(node.Prev, node)
+
+	return node.Data, nil
+}
+
+// MoveToFront moves the node to the head of the list.
+func (list *Double[T]) MoveToFront(node *ListNode[T]) error {
+	if !list.owns(node) {
+		return ErrNodeNotInList
+	}
+
+	if list.Head != node {
+		list.unlinkAfter(node.Prev, node)
+		list.linkAfter(nil, node)
+	}
+
+	return nil
+}
+
+// MoveToBack moves the node to the tail of the list.
+func (list *Double[T]) MoveToBack(node *ListNode[T]) error {
+	if !list.owns(node) {
+		return ErrNodeNotInList
+	}
+
+	if list.Tail != node {
+		list.unlinkAfter(node.Prev, node)
+		list.linkAfter(list.Tail, node)
+	}
+
+	return nil
+}
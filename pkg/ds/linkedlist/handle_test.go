@@ -0,0 +1,171 @@
+package linkedlist_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/linkedlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type NodeHandleTestSuite struct {
+	suite.Suite
+	list *linkedlist.Double[string]
+}
+
+func TestNodeHandleTestSuite(t *testing.T) {
+	suite.Run(t, new(NodeHandleTestSuite))
+}
+
+func (suite *NodeHandleTestSuite) SetupTest() {
+	suite.list = linkedlist.NewDoubleEmpty[string]()
+}
+
+func (suite *NodeHandleTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.list.CheckInvariants())
+}
+
+func (suite *NodeHandleTestSuite) values() []string {
+	return slices.Collect(suite.list.All())
+}
+
+func (suite *NodeHandleTestSuite) TestInsertNodes() {
+	b := suite.list.InsertHeadNode("b")
+	c := suite.list.InsertTailNode("c")
+	a := suite.list.InsertHeadNode("a")
+
+	assert.Equal(suite.T(), "a", a.Data)
+	assert.Equal(suite.T(), b, a.Next)
+	assert.Equal(suite.T(), c, suite.list.GetTail())
+	assert.Equal(suite.T(), []string{"a", "b", "c"}, suite.values())
+}
+
+func (suite *NodeHandleTestSuite) TestInsertBeforeAndAfter() {
+	b := suite.list.InsertTailNode("b")
+
+	a, err := suite.list.InsertBefore(b, "a")
+	require.NoError(suite.T(), err)
+
+	c, err := suite.list.InsertAfter(b, "c")
+	require.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), a, suite.list.GetHead())
+	assert.Equal(suite.T(), c, suite.list.GetTail())
+	assert.Equal(suite.T(), []string{"a", "b", "c"}, suite.values())
+}
+
+func (suite *NodeHandleTestSuite) TestRemove() {
+	a := suite.list.InsertTailNode("a")
+	b := suite.list.InsertTailNode("b")
+	c := suite.list.InsertTailNode("c")
+
+	value, err := suite.list.Remove(b)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "b", value)
+
+	_, err = suite.list.Remove(c)
+	require.NoError(suite.T(), err)
+
+	_, err = suite.list.Remove(a)
+	require.NoError(suite.T(), err)
+
+	assert.True(suite.T(), suite.list.IsEmpty())
+}
+
+func (suite *NodeHandleTestSuite) TestMoves() {
+	a := suite.list.InsertTailNode("a")
+	suite.list.InsertTailNode("b")
+	c := suite.list.InsertTailNode("c")
+
+	require.NoError(suite.T(), suite.list.MoveToFront(c))
+	assert.Equal(suite.T(), []string{"c", "a", "b"}, suite.values())
+
+	require.NoError(suite.T(), suite.list.MoveToBack(a))
+	assert.Equal(suite.T(), []string{"c", "b", "a"}, suite.values())
+
+	// Moving a node that is already in place is a no-op.
+	require.NoError(suite.T(), suite.list.MoveToFront(c))
+	require.NoError(suite.T(), suite.list.MoveToBack(a))
+	assert.Equal(suite.T(), []string{"c", "b", "a"}, suite.values())
+}
+
+func (suite *NodeHandleTestSuite) TestRejectsForeignNodes() {
+	suite.list.InsertTailNode("a")
+
+	other := linkedlist.NewDoubleEmpty[string]()
+	foreign := other.InsertTailNode("x")
+	single := linkedlist.NewSingleWithHead("y")
+
+	for _, node := range []*linkedlist.ListNode[string]{foreign, single.GetHead(), linkedlist.NewListNodeWithData("z"), nil} {
+		_, err := suite.list.Remove(node)
+		assert.ErrorIs(suite.T(), err, linkedlist.ErrNodeNotInList)
+
+		_, err = suite.list.InsertBefore(node, "q")
+		assert.ErrorIs(suite.T(), err, linkedlist.ErrNodeNotInList)
+
+		_, err = suite.list.InsertAfter(node, "q")
+		assert.ErrorIs(suite.T(), err, linkedlist.ErrNodeNotInList)
+
+		assert.ErrorIs(suite.T(), suite.list.MoveToFront(node), linkedlist.ErrNodeNotInList)
+		assert.ErrorIs(suite.T(), suite.list.MoveToBack(node), linkedlist.ErrNodeNotInList)
+	}
+
+	assert.Equal(suite.T(), []string{"a"}, suite.values())
+	assert.Equal(suite.T(), []string{"x"}, slices.Collect(other.All()))
+	assert.NoError(suite.T(), other.CheckInvariants())
+}
+
+func (suite *NodeHandleTestSuite) TestRejectsRemovedNodes() {
+	a := suite.list.InsertTailNode("a")
+	b := suite.list.InsertTailNode("b")
+
+	_, err := suite.list.Remove(a)
+	require.NoError(suite.T(), err)
+
+	_, err = suite.list.Remove(a)
+	assert.ErrorIs(suite.T(), err, linkedlist.ErrNodeNotInList)
+
+	// Nodes removed by the positional API are disowned too.
+	suite.list.DeleteHead()
+
+	assert.ErrorIs(suite.T(), suite.list.MoveToFront(b), linkedlist.ErrNodeNotInList)
+}
+
+func (suite *NodeHandleTestSuite) TestRejectsNodesAfterClear() {
+	a := suite.list.InsertTailNode("a")
+	suite.list.ClearList()
+
+	_, err := suite.list.Remove(a)
+
+	assert.ErrorIs(suite.T(), err, linkedlist.ErrNodeNotInList)
+	assert.True(suite.T(), suite.list.IsEmpty())
+}
+
+func (suite *NodeHandleTestSuite) TestLRU() {
+	capacity := 3
+	nodes := make(map[string]*linkedlist.ListNode[string])
+
+	// access touches a key, evicting the least recently used key when full.
+	access := func(key string) {
+		if node, exists := nodes[key]; exists {
+			require.NoError(suite.T(), suite.list.MoveToFront(node))
+
+			return
+		}
+
+		if suite.list.Len() == capacity {
+			evicted, _ := suite.list.Remove(suite.list.GetTail())
+			delete(nodes, evicted)
+		}
+
+		nodes[key] = suite.list.InsertHeadNode(key)
+	}
+
+	for _, key := range []string{"a", "b", "c", "a", "d", "b", "e"} {
+		access(key)
+	}
+
+	assert.Equal(suite.T(), []string{"e", "b", "d"}, suite.values())
+}
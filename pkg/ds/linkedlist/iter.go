@@ -0,0 +1,74 @@
+// Package linkedlist implements the Linked List Data Structure.
+package linkedlist
+
+import "iter"
+
+// All returns an iterator over the data in the list from head to tail.
+// The next node is read before yielding, so the current node may be removed while iterating.
+func (list *Single[T]) All() iter.Seq[T] {
+	return forward(list.GetHead)
+}
+
+// Enumerate returns an iterator over the positions and data in the list from head to tail.
+func (list *Single[T]) Enumerate() iter.Seq2[int, T] {
+	return enumerate(list.GetHead)
+}
+
+// All returns an iterator over the data in the list from head to tail.
+// The next node is read before yielding, so the current node may be removed while iterating.
+func (list *Double[T]) All() iter.Seq[T] {
+	return forward(list.GetHead)
+}
+
+// Enumerate returns an iterator over the positions and data in the list from head to tail.
+func (list *Double[T]) Enumerate() iter.Seq2[int, T] {
+	return enumerate(list.GetHead)
+}
+
+// Backward returns an iterator over the data in the list from tail to head.
+// The previous node is read before yielding, so the current node may be removed while iterating.
+func (list *Double[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := list.Tail; node != nil; {
+			prev := node.Prev
+			if !yield(node.Data) {
+				return
+			}
+
+			node = prev
+		}
+	}
+}
+
+// forward returns an iterator over the data of the head node and every node after it.
+// The head is looked up when iteration starts.
+func forward[T comparable](head func() *ListNode[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := head(); node != nil; {
+			next := node.Next
+			if !yield(node.Data) {
+				return
+			}
+
+			node = next
+		}
+	}
+}
+
+// enumerate returns an iterator over the positions and data of the head node and every node
+// after it. The head is looked up when iteration starts.
+func enumerate[T comparable](head func() *ListNode[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		position := 0
+
+		for node := head(); node != nil; {
+			next := node.Next
+			if !yield(position, node.Data) {
+				return
+			}
+
+			node = next
+			position++
+		}
+	}
+}
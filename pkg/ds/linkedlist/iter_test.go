@@ -0,0 +1,86 @@
+package linkedlist_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/linkedlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type IteratorTestSuite struct {
+	suite.Suite
+	singleList *linkedlist.Single[int]
+	doubleList *linkedlist.Double[int]
+}
+
+func TestIteratorTestSuite(t *testing.T) {
+	suite.Run(t, new(IteratorTestSuite))
+}
+
+func (suite *IteratorTestSuite) SetupTest() {
+	suite.singleList = linkedlist.NewSingleEmpty[int]()
+	suite.doubleList = linkedlist.NewDoubleEmpty[int]()
+
+	for i := 1; i <= 4; i++ {
+		suite.singleList.InsertTail(i * 10)
+		suite.doubleList.InsertTail(i * 10)
+	}
+}
+
+func (suite *IteratorTestSuite) TestAll() {
+	expected := []int{10, 20, 30, 40}
+
+	assert.Equal(suite.T(), expected, slices.Collect(suite.singleList.All()))
+	assert.Equal(suite.T(), expected, slices.Collect(suite.doubleList.All()))
+}
+
+func (suite *IteratorTestSuite) TestAllEmpty() {
+	assert.Empty(suite.T(), slices.Collect(linkedlist.NewSingleEmpty[int]().All()))
+	assert.Empty(suite.T(), slices.Collect(linkedlist.NewDoubleEmpty[int]().All()))
+	assert.Empty(suite.T(), slices.Collect(linkedlist.NewDoubleEmpty[int]().Backward()))
+}
+
+func (suite *IteratorTestSuite) TestAllStopsEarly() {
+	var seen []int
+
+	for value := range suite.singleList.All() {
+		seen = append(seen, value)
+		if value == 20 {
+			break
+		}
+	}
+
+	assert.Equal(suite.T(), []int{10, 20}, seen)
+}
+
+func (suite *IteratorTestSuite) TestAllSeesLaterChanges() {
+	values := suite.doubleList.All()
+
+	// The head is looked up when iteration starts, not when All is called.
+	suite.doubleList.InsertHead(0)
+
+	assert.Equal(suite.T(), []int{0, 10, 20, 30, 40}, slices.Collect(values))
+}
+
+func (suite *IteratorTestSuite) TestBackward() {
+	assert.Equal(suite.T(), []int{40, 30, 20, 10}, slices.Collect(suite.doubleList.Backward()))
+}
+
+func (suite *IteratorTestSuite) TestEnumerate() {
+	positions := []int{}
+	values := []int{}
+
+	for position, value := range suite.singleList.Enumerate() {
+		positions = append(positions, position)
+		values = append(values, value)
+	}
+
+	assert.Equal(suite.T(), []int{0, 1, 2, 3}, positions)
+	assert.Equal(suite.T(), []int{10, 20, 30, 40}, values)
+
+	for position, value := range suite.doubleList.Enumerate() {
+		assert.Equal(suite.T(), (position+1)*10, value)
+	}
+}
@@ -0,0 +1,24 @@
+// Package linkedlist implements the Linked List Data Structure.
+package linkedlist
+
+import "fmt"
+
+// Josephus removes every step-th node from the circular list, counting from its current
+// node, until the list is empty, and returns the data in the order it was removed. A step
+// of 1 removes the nodes in order, starting with the current one.
+func Josephus[T comparable](list Circular[T], step int) ([]T, error) {
+	if step < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidStep, step)
+	}
+
+	order := make([]T, 0, list.Len())
+
+	for !list.IsEmpty() {
+		list.Advance(step - 1)
+
+		data, _ := list.RemoveCurrent()
+		order = append(order, data)
+	}
+
+	return order, nil
+}
@@ -1,28 +1,83 @@
 // Package linkedlist implements the Linked List Data Structure.
 package linkedlist
 
-import "errors"
+import (
+	"errors"
+	"iter"
+)
 
-// ErrInvalidPosition is an error indicating an invalid position in the linked list.
-var ErrInvalidPosition = errors.New("invalid position")
+var (
+	// ErrInvalidPosition is an error indicating an invalid position in the linked list.
+	ErrInvalidPosition = errors.New("invalid position")
+
+	// ErrNodeNotInList is an error indicating a node handle doesn't belong to the linked list.
+	ErrNodeNotInList = errors.New("node not in list")
+
+	// ErrInvalidStep is an error indicating a step count that isn't positive.
+	ErrInvalidStep = errors.New("invalid step")
+
+	// ErrInvalidCapacity is an error indicating a node capacity that is too small.
+	ErrInvalidCapacity = errors.New("invalid capacity")
+)
+
+// Inserter interface defines the insertions into a list.
+type Inserter[T comparable] interface {
+	InsertAtPosition(position int, data T) (bool, error)
+	InsertHead(data T) bool
+	InsertTail(data T) bool
+}
+
+// Deleter interface defines the deletions from a list.
+type Deleter[T comparable] interface {
+	DeleteAtPosition(position int) (T, error)
+	DeleteHead() (T, bool)
+	DeleteTail() (T, bool)
+}
+
+// Collection interface defines the operations on a list as a whole.
+type Collection[T comparable] interface {
+	ClearList()
+	IsEmpty() bool
+	IsValuePresent(data T) bool
+	Len() int
+}
 
 // LinkedList interface defines the operations for a linked list.
 type LinkedList[T comparable] interface {
-	DeleteAtPosition(position int) (any, bool, error)
-	DeleteHead() (any, bool)
-	DeleteTail() (any, bool)
-	InsertAtPosition(any, int) (bool, error)
-	InsertHead(data any) bool
-	InsertTail(data any) bool
+	Inserter[T]
+	Deleter[T]
 	GetHead() *ListNode[T]
 	GetTail() *ListNode[T]
 }
 
 // ListHelper interface defines additional operations for a linked list.
 type ListHelper[T comparable] interface {
-	ClearList()
-	SetHeadIfEmpty(newNode *ListNode[T]) bool
-	IsEmpty() bool
-	IsPresent(data any) bool
-	Len() int
+	Collection[T]
+	SetHeadIfEmpty(newHead *ListNode[T]) bool
+}
+
+// List interface combines the LinkedList and ListHelper operations.
+type List[T comparable] interface {
+	LinkedList[T]
+	ListHelper[T]
+}
+
+// Sequence interface defines the operations on the data in a list, without exposing its nodes.
+// Every list in this package satisfies it, including those that don't store one node per element.
+type Sequence[T comparable] interface {
+	Inserter[T]
+	Deleter[T]
+	Collection[T]
+	All() iter.Seq[T]
+}
+
+// Circular interface defines the operations for a circular linked list, whose tail links back
+// to its head. It keeps a current node that can be advanced around the ring.
+type Circular[T comparable] interface {
+	List[T]
+	All() iter.Seq[T]
+	Advance(steps int)
+	Current() (T, bool)
+	RemoveCurrent() (T, bool)
+	Rotate(k int)
 }
@@ -6,6 +6,7 @@ package linkedlist
 type ListNode[T comparable] struct {
 	Data       T            // The data stored in the node
 	Prev, Next *ListNode[T] // Pointer to the previous and next node.
+	owner      *listID      // The Double list the node belongs to, if any.
 }
 
 // NewEmptyListNode returns a new empty list node.
@@ -0,0 +1,189 @@
+package linkedlist_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/linkedlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// modelOperations is the number of random operations applied in every model-based run.
+const modelOperations = 5000
+
+// TestSingleAgainstSliceModel compares a Single list against a plain slice.
+func TestSingleAgainstSliceModel(t *testing.T) {
+	for seed := range uint64(5) {
+		runSliceModel(t, linkedlist.NewSingleEmpty[int](), seed)
+	}
+}
+
+// TestDoubleAgainstSliceModel compares a Double list against a plain slice.
+func TestDoubleAgainstSliceModel(t *testing.T) {
+	for seed := range uint64(5) {
+		list := linkedlist.NewDoubleEmpty[int]()
+		runSliceModel(t, list, seed)
+
+		// The Prev pointers must mirror the Next pointers.
+		var backward []int
+		for node := list.GetTail(); node != nil; node = node.Prev {
+			backward = append(backward, node.Data)
+		}
+
+		forward := listValues(list)
+		slices.Reverse(forward)
+		assert.Equal(t, forward, backward)
+	}
+}
+
+// TestCircularSingleAgainstSliceModel compares a CircularSingle list against a plain slice.
+func TestCircularSingleAgainstSliceModel(t *testing.T) {
+	for seed := range uint64(5) {
+		runSliceModel(t, linkedlist.NewCircularSingleEmpty[int](), seed)
+	}
+}
+
+// TestCircularDoubleAgainstSliceModel compares a CircularDouble list against a plain slice.
+func TestCircularDoubleAgainstSliceModel(t *testing.T) {
+	for seed := range uint64(5) {
+		runSliceModel(t, linkedlist.NewCircularDoubleEmpty[int](), seed)
+	}
+}
+
+// TestUnrolledAgainstSliceModel compares Unrolled lists with small nodes, which split and
+// merge often, against a plain slice.
+func TestUnrolledAgainstSliceModel(t *testing.T) {
+	for _, capacity := range []int{2, 3, 8} {
+		for seed := range uint64(5) {
+			list, err := linkedlist.NewUnrolledWithCapacity[int](capacity)
+			require.NoError(t, err)
+
+			runSliceModel(t, list, seed)
+		}
+	}
+}
+
+// TestSynchronizedAgainstSliceModel compares Synchronized lists wrapping each kind of list
+// against a plain slice.
+func TestSynchronizedAgainstSliceModel(t *testing.T) {
+	for _, newList := range []func() *linkedlist.Synchronized[int]{
+		linkedlist.NewSynchronized[int],
+		linkedlist.NewSynchronizedSingle[int],
+	} {
+		for seed := range uint64(5) {
+			runSliceModel(t, newList(), seed)
+		}
+	}
+}
+
+// checkedSequence is a Sequence whose internal bookkeeping can be verified.
+type checkedSequence interface {
+	linkedlist.Sequence[int]
+	CheckInvariants() error
+}
+
+// runSliceModel applies random operations to both the list and a slice, checking after every
+// operation that they agree and that the list's invariants hold.
+func runSliceModel(t *testing.T, list checkedSequence, seed uint64) {
+	t.Helper()
+
+	rng := rand.New(rand.NewPCG(seed, seed))
+	model := []int{}
+
+	for step := range modelOperations {
+		value := rng.IntN(100)
+		// Positions deliberately include invalid ones just outside the list.
+		position := rng.IntN(len(model)+3) - 1
+
+		switch rng.IntN(8) {
+		case 0:
+			list.InsertHead(value)
+			model = slices.Insert(model, 0, value)
+		case 1:
+			list.InsertTail(value)
+			model = append(model, value)
+		case 2:
+			inserted, err := list.InsertAtPosition(position, value)
+			if position >= 0 && position <= len(model) {
+				require.NoError(t, err)
+				assert.True(t, inserted)
+
+				model = slices.Insert(model, position, value)
+			} else {
+				require.ErrorIs(t, err, linkedlist.ErrInvalidPosition)
+			}
+		case 3:
+			actual, deleted := list.DeleteHead()
+			require.Equal(t, len(model) > 0, deleted)
+
+			if deleted {
+				require.Equal(t, model[0], actual)
+
+				model = model[1:]
+			}
+		case 4:
+			actual, deleted := list.DeleteTail()
+			require.Equal(t, len(model) > 0, deleted)
+
+			if deleted {
+				require.Equal(t, model[len(model)-1], actual)
+
+				model = model[:len(model)-1]
+			}
+		case 5:
+			actual, err := list.DeleteAtPosition(position)
+			if position >= 0 && position < len(model) {
+				require.NoError(t, err)
+				require.Equal(t, model[position], actual)
+
+				model = slices.Delete(model, position, position+1)
+			} else {
+				require.ErrorIs(t, err, linkedlist.ErrInvalidPosition)
+			}
+		case 6:
+			require.Equal(t, slices.Contains(model, value), list.IsValuePresent(value))
+		default:
+			// Clearing is rare so the list has a chance to grow.
+			if rng.IntN(50) == 0 {
+				list.ClearList()
+
+				model = model[:0]
+			}
+		}
+
+		require.NoError(t, list.CheckInvariants(), "seed %d step %d", seed, step)
+		require.Equal(t, model, sequenceValues(list), "seed %d step %d", seed, step)
+		require.Equal(t, len(model), list.Len())
+	}
+}
+
+// sequenceValues returns the list's data in order.
+func sequenceValues(list linkedlist.Sequence[int]) []int {
+	values := []int{}
+	for value := range list.All() {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// listValues returns the list's data by following the Next pointers from the head. It stops
+// after Len nodes so it also works on circular lists, whose tail links back to the head;
+// CheckInvariants verifies a linear list really ends there.
+func listValues(list linkedlist.List[int]) []int {
+	values := []int{}
+	node := list.GetHead()
+
+	for range list.Len() {
+		if node == nil {
+			break
+		}
+
+		values = append(values, node.Data)
+		node = node.Next
+	}
+
+	return values
+}
@@ -0,0 +1,209 @@
+// Package linkedlist implements the Linked List Data Structure.
+package linkedlist
+
+import "fmt"
+
+// Get returns the data at the position.
+func (list *Single[T]) Get(position int) (T, error) {
+	if list.isOutOfRange(position) {
+		var zero T
+
+		return zero, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	return list.nodeAt(position).Data, nil
+}
+
+// Get returns the data at the position, walking from the nearer end.
+func (list *Double[T]) Get(position int) (T, error) {
+	if list.isOutOfRange(position) {
+		var zero T
+
+		return zero, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	return list.nodeAt(position).Data, nil
+}
+
+// Set replaces the data at the position.
+func (list *Single[T]) Set(position int, data T) error {
+	if list.isOutOfRange(position) {
+		return fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	list.nodeAt(position).Data = data
+
+	return nil
+}
+
+// Set replaces the data at the position, walking from the nearer end.
+func (list *Double[T]) Set(position int, data T) error {
+	if list.isOutOfRange(position) {
+		return fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	list.nodeAt(position).Data = data
+
+	return nil
+}
+
+// IndexOf returns the position of the first node holding the data, or -1 if there is none.
+func (list *Single[T]) IndexOf(data T) int {
+	return indexOf(list.Head, data)
+}
+
+// IndexOf returns the position of the first node holding the data, or -1 if there is none.
+func (list *Double[T]) IndexOf(data T) int {
+	return indexOf(list.Head, data)
+}
+
+// LastIndexOf returns the position of the last node holding the data, or -1 if there is none.
+func (list *Single[T]) LastIndexOf(data T) int {
+	last := -1
+	position := 0
+
+	for node := list.Head; node != nil; node = node.Next {
+		if node.Data == data {
+			last = position
+		}
+
+		position++
+	}
+
+	return last
+}
+
+// LastIndexOf returns the position of the last node holding the data, or -1 if there is none.
+// It walks from the tail, so it stops at the first match from that end.
+func (list *Double[T]) LastIndexOf(data T) int {
+	position := list.len - 1
+
+	for node := list.Tail; node != nil; node = node.Prev {
+		if node.Data == data {
+			return position
+		}
+
+		position--
+	}
+
+	return -1
+}
+
+// Find returns the data of the first node that satisfies the predicate.
+func (list *Single[T]) Find(predicate func(T) bool) (T, bool) {
+	return find(list.Head, predicate)
+}
+
+// Find returns the data of the first node that satisfies the predicate.
+func (list *Double[T]) Find(predicate func(T) bool) (T, bool) {
+	return find(list.Head, predicate)
+}
+
+// RemoveIf removes every node that satisfies the predicate and returns how many were removed.
+func (list *Single[T]) RemoveIf(predicate func(T) bool) int {
+	return removeIf(list, list.Head, predicate)
+}
+
+// RemoveIf removes every node that satisfies the predicate and returns how many were removed.
+func (list *Double[T]) RemoveIf(predicate func(T) bool) int {
+	return removeIf(list, list.Head, predicate)
+}
+
+// RemoveAll removes every node holding the data and returns how many were removed.
+func (list *Single[T]) RemoveAll(data T) int {
+	return list.RemoveIf(func(value T) bool { return value == data })
+}
+
+// RemoveAll removes every node holding the data and returns how many were removed.
+func (list *Double[T]) RemoveAll(data T) int {
+	return list.RemoveIf(func(value T) bool { return value == data })
+}
+
+// Dedup removes nodes holding the same data as the node before them and returns how many
+// were removed. On a sorted list this leaves every value once.
+func (list *Single[T]) Dedup() int {
+	return dedup(list, list.Head)
+}
+
+// Dedup removes nodes holding the same data as the node before them and returns how many
+// were removed. On a sorted list this leaves every value once.
+func (list *Double[T]) Dedup() int {
+	return dedup(list, list.Head)
+}
+
+// indexOf returns the position of the first node from head holding the data, or -1.
+func indexOf[T comparable](head *ListNode[T], data T) int {
+	position := 0
+
+	for node := head; node != nil; node = node.Next {
+		if node.Data == data {
+			return position
+		}
+
+		position++
+	}
+
+	return -1
+}
+
+// find returns the data of the first node from head that satisfies the predicate.
+func find[T comparable](head *ListNode[T], predicate func(T) bool) (T, bool) {
+	for node := head; node != nil; node = node.Next {
+		if predicate(node.Data) {
+			return node.Data, true
+		}
+	}
+
+	var zero T
+
+	return zero, false
+}
+
+// removeIf unlinks every node from head that satisfies the predicate and returns the count.
+func removeIf[T comparable](list linker[T], head *ListNode[T], predicate func(T) bool) int {
+	removed := 0
+
+	var prev *ListNode[T]
+
+	for node := head; node != nil; {
+		// Unlinking clears Next, so read it first.
+		next := node.Next
+
+		if predicate(node.Data) {
+			list.unlinkAfter(prev, node)
+			removed++
+		} else {
+			prev = node
+		}
+
+		node = next
+	}
+
+	return removed
+}
+
+// dedup unlinks every node from head holding the same data as the node kept before it and
+// returns the count.
+func dedup[T comparable](list linker[T], head *ListNode[T]) int {
+	if head == nil {
+		return 0
+	}
+
+	removed := 0
+	prev := head
+
+	for node := head.Next; node != nil; {
+		next := node.Next
+
+		if node.Data == prev.Data {
+			list.unlinkAfter(prev, node)
+			removed++
+		} else {
+			prev = node
+		}
+
+		node = next
+	}
+
+	return removed
+}
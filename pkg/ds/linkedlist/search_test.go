@@ -0,0 +1,146 @@
+package linkedlist_test
+
+import (
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/linkedlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// searchList is a list with the search and removal operations.
+type searchList interface {
+	checkedList
+	Get(position int) (int, error)
+	Set(position int, data int) error
+	IndexOf(data int) int
+	LastIndexOf(data int) int
+	Find(predicate func(int) bool) (int, bool)
+	RemoveIf(predicate func(int) bool) int
+	RemoveAll(data int) int
+	Dedup() int
+}
+
+// SearchTestSuite checks the search and removal operations of every list.
+type SearchTestSuite struct {
+	suite.Suite
+	newList func() searchList
+	list    searchList
+}
+
+func TestSingleSearchTestSuite(t *testing.T) {
+	suite.Run(t, &SearchTestSuite{
+		newList: func() searchList { return linkedlist.NewSingleEmpty[int]() },
+	})
+}
+
+func TestDoubleSearchTestSuite(t *testing.T) {
+	suite.Run(t, &SearchTestSuite{
+		newList: func() searchList { return linkedlist.NewDoubleEmpty[int]() },
+	})
+}
+
+func (suite *SearchTestSuite) SetupTest() {
+	suite.list = suite.newList()
+	for _, value := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+		suite.list.InsertTail(value)
+	}
+}
+
+func (suite *SearchTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.list.CheckInvariants())
+}
+
+func (suite *SearchTestSuite) TestGet() {
+	// Check every position so both ends of a Double list are walked.
+	for position, expected := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+		actual, err := suite.list.Get(position)
+
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), expected, actual)
+	}
+
+	for _, position := range []int{-1, 8} {
+		actual, err := suite.list.Get(position)
+
+		assert.Zero(suite.T(), actual)
+		assert.ErrorIs(suite.T(), err, linkedlist.ErrInvalidPosition)
+	}
+}
+
+func (suite *SearchTestSuite) TestSet() {
+	require.NoError(suite.T(), suite.list.Set(0, 30))
+	require.NoError(suite.T(), suite.list.Set(6, 20))
+	assert.ErrorIs(suite.T(), suite.list.Set(8, 0), linkedlist.ErrInvalidPosition)
+
+	assert.Equal(suite.T(), []int{30, 1, 4, 1, 5, 9, 20, 6}, listValues(suite.list))
+}
+
+func (suite *SearchTestSuite) TestIndexOf() {
+	assert.Equal(suite.T(), 1, suite.list.IndexOf(1))
+	assert.Equal(suite.T(), 7, suite.list.IndexOf(6))
+	assert.Equal(suite.T(), -1, suite.list.IndexOf(7))
+}
+
+func (suite *SearchTestSuite) TestLastIndexOf() {
+	assert.Equal(suite.T(), 3, suite.list.LastIndexOf(1))
+	assert.Equal(suite.T(), 0, suite.list.LastIndexOf(3))
+	assert.Equal(suite.T(), -1, suite.list.LastIndexOf(7))
+}
+
+func (suite *SearchTestSuite) TestFind() {
+	value, found := suite.list.Find(func(value int) bool { return value > 4 })
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), 5, value)
+
+	value, found = suite.list.Find(func(value int) bool { return value > 9 })
+	assert.False(suite.T(), found)
+	assert.Zero(suite.T(), value)
+}
+
+func (suite *SearchTestSuite) TestRemoveIf() {
+	removed := suite.list.RemoveIf(func(value int) bool { return value%2 == 1 })
+
+	assert.Equal(suite.T(), 5, removed)
+	assert.Equal(suite.T(), []int{4, 2, 6}, listValues(suite.list))
+}
+
+func (suite *SearchTestSuite) TestRemoveIfHeadAndTail() {
+	removed := suite.list.RemoveIf(func(value int) bool { return value == 3 || value == 6 })
+
+	assert.Equal(suite.T(), 2, removed)
+	assert.Equal(suite.T(), 1, suite.list.GetHead().Data)
+	assert.Equal(suite.T(), 2, suite.list.GetTail().Data)
+}
+
+func (suite *SearchTestSuite) TestRemoveIfEverything() {
+	removed := suite.list.RemoveIf(func(int) bool { return true })
+
+	assert.Equal(suite.T(), 8, removed)
+	assert.True(suite.T(), suite.list.IsEmpty())
+}
+
+func (suite *SearchTestSuite) TestRemoveAll() {
+	assert.Equal(suite.T(), 2, suite.list.RemoveAll(1))
+	assert.Equal(suite.T(), 0, suite.list.RemoveAll(7))
+	assert.Equal(suite.T(), []int{3, 4, 5, 9, 2, 6}, listValues(suite.list))
+}
+
+func (suite *SearchTestSuite) TestDedup() {
+	suite.list.ClearList()
+
+	for _, value := range []int{1, 1, 2, 3, 3, 3, 4, 4} {
+		suite.list.InsertTail(value)
+	}
+
+	assert.Equal(suite.T(), 4, suite.list.Dedup())
+	assert.Equal(suite.T(), []int{1, 2, 3, 4}, listValues(suite.list))
+	assert.Equal(suite.T(), 4, suite.list.GetTail().Data)
+}
+
+func (suite *SearchTestSuite) TestDedupEmpty() {
+	suite.list.ClearList()
+
+	assert.Equal(suite.T(), 0, suite.list.Dedup())
+}
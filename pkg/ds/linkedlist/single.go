@@ -3,6 +3,9 @@ package linkedlist
 
 import "fmt"
 
+// Single satisfies the linked list interfaces.
+var _ List[int] = (*Single[int])(nil)
+
 // Single represents a singly linked list.
 type Single[T comparable] struct {
 	Head, Tail *ListNode[T]
@@ -32,17 +35,15 @@ func (list *Single[T]) ClearList() {
 	list.len = 0
 }
 
-// DeleteAtPosition deletes a list node at a specific position.
-func (list *Single[T]) DeleteAtPosition(position int) (any, bool, error) {
-	if list.isInvalidPosition(position) {
-		return nil, false, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
-	}
+// DeleteAtPosition deletes a list node at a specific position and returns its data.
+func (list *Single[T]) DeleteAtPosition(position int) (T, error) {
+	if list.isOutOfRange(position) {
+		var zero T
 
-	if list.IsEmpty() {
-		return 0, false, nil
+		return zero, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
 	}
 
-	var value any
+	var value T
 	if position == 0 {
 		value = list.Head.Data
 		list.Head = list.Head.Next
@@ -53,7 +54,7 @@ func (list *Single[T]) DeleteAtPosition(position int) (any, bool, error) {
 
 		list.len--
 
-		return value, true, nil
+		return value, nil
 	}
 
 	current := list.Head
@@ -70,26 +71,35 @@ func (list *Single[T]) DeleteAtPosition(position int) (any, bool, error) {
 
 	list.len--
 
-	return value, true, nil
+	return value, nil
 }
 
 // DeleteHead deletes the head node.
-func (list *Single[T]) DeleteHead() (any, bool) {
+func (list *Single[T]) DeleteHead() (T, bool) {
 	if list.IsEmpty() {
-		return 0, false
+		var zero T
+
+		return zero, false
 	}
 
 	value := list.Head.Data
 	list.Head = list.Head.Next
+
+	if list.Head == nil {
+		list.Tail = nil
+	}
+
 	list.len--
 
 	return value, true
 }
 
-// DeleteTail should delete the tail node from the list.
-func (list *Single[T]) DeleteTail() (any, bool) {
+// DeleteTail deletes the tail node.
+func (list *Single[T]) DeleteTail() (T, bool) {
 	if list.IsEmpty() {
-		return 0, false
+		var zero T
+
+		return zero, false
 	}
 
 	if list.len == 1 {
@@ -106,6 +116,7 @@ func (list *Single[T]) DeleteTail() (any, bool) {
 
 	value := current.Next.Data
 	current.Next = nil
+	list.Tail = current
 	list.len--
 
 	return value, true
@@ -116,27 +127,43 @@ func (list *Single[T]) IsEmpty() bool {
 	return list.Len() == 0
 }
 
-// isInvalidPosition checks to see if a list position is invalid.
+// isInvalidPosition checks to see if a list position is invalid for inserting a node.
 func (list *Single[T]) isInvalidPosition(position int) bool {
 	return position < 0 || position > list.Len()
 }
 
+// isOutOfRange checks to see if a list position doesn't hold a node.
+func (list *Single[T]) isOutOfRange(position int) bool {
+	return position < 0 || position >= list.Len()
+}
+
+// nodeAt returns the node at a position that holds a node.
+func (list *Single[T]) nodeAt(position int) *ListNode[T] {
+	current := list.Head
+	for i := 0; i < position; i++ {
+		current = current.Next
+	}
+
+	return current
+}
+
 // InsertAtPosition will insert a node into the list at the given position.
 func (list *Single[T]) InsertAtPosition(position int, data T) (bool, error) {
 	if list.isInvalidPosition(position) {
 		return false, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
 	}
 
-	newNode := NewListNodeWithData(data)
-	if list.SetHeadIfEmpty(newNode) {
-		return true, nil
+	if position == 0 {
+		return list.InsertHead(data), nil
 	}
 
-	current := list.Head
-	for i := 0; i < position-1; i++ {
-		current = current.Next
+	if position == list.len {
+		return list.InsertTail(data), nil
 	}
 
+	newNode := NewListNodeWithData(data)
+
+	current := list.nodeAt(position - 1)
 	newNode.Next = current.Next
 	current.Next = newNode
 	list.len++
@@ -216,3 +243,36 @@ func (list *Single[T]) GetTail() *ListNode[T] {
 func (list *Single[T]) Len() int {
 	return list.len
 }
+
+// linkAfter links node into the list right after prev, or at the head if prev is nil.
+func (list *Single[T]) linkAfter(prev, node *ListNode[T]) {
+	if prev == nil {
+		node.Next = list.Head
+		list.Head = node
+	} else {
+		node.Next = prev.Next
+		prev.Next = node
+	}
+
+	if node.Next == nil {
+		list.Tail = node
+	}
+
+	list.len++
+}
+
+// unlinkAfter unlinks node from the list. prev must be the node before it, or nil if node is the head.
+func (list *Single[T]) unlinkAfter(prev, node *ListNode[T]) {
+	if prev == nil {
+		list.Head = node.Next
+	} else {
+		prev.Next = node.Next
+	}
+
+	if list.Tail == node {
+		list.Tail = prev
+	}
+
+	node.Next = nil
+	list.len--
+}
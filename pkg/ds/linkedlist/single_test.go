@@ -243,10 +243,9 @@ func (suite *SingleLinkedListTestSuite) TestEmptyListDeleteAtInvalidPosition() {
 	position := 5
 	errMsg := fmt.Errorf("%v: %d", linkedlist.ErrInvalidPosition, position)
 	suite.singleList = linkedlist.NewSingleEmpty[int]()
-	actualValue, deleted, err := suite.singleList.DeleteAtPosition(position)
+	actualValue, err := suite.singleList.DeleteAtPosition(position)
 	assert.Equal(suite.T(), expectedLen, suite.singleList.Len())
-	assert.Nil(suite.T(), actualValue)
-	assert.False(suite.T(), deleted)
+	assert.Zero(suite.T(), actualValue)
 	assert.ErrorIs(suite.T(), err, linkedlist.ErrInvalidPosition, errMsg)
 }
 
@@ -260,11 +259,10 @@ func (suite *SingleLinkedListTestSuite) TestEmptyListDeleteAtFirstPosition() {
 	suite.singleList.InsertHead(4)
 	suite.singleList.InsertHead(5)
 	suite.singleList.InsertHead(expectedValue)
-	actualValue, deleted, err := suite.singleList.DeleteAtPosition(position)
+	actualValue, err := suite.singleList.DeleteAtPosition(position)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), expectedLen, suite.singleList.Len())
 	assert.Equal(suite.T(), expectedValue, actualValue)
-	assert.True(suite.T(), deleted)
 }
 
 func (suite *SingleLinkedListTestSuite) TestEmptyListDeleteAtNFirstPosition() {
@@ -277,11 +275,10 @@ func (suite *SingleLinkedListTestSuite) TestEmptyListDeleteAtNFirstPosition() {
 	suite.singleList.InsertHead(4)
 	suite.singleList.InsertHead(5)
 	suite.singleList.InsertHead(6)
-	actualValue, deleted, err := suite.singleList.DeleteAtPosition(position)
+	actualValue, err := suite.singleList.DeleteAtPosition(position)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), expectedLen, suite.singleList.Len())
 	assert.Equal(suite.T(), expectedValue, actualValue)
-	assert.True(suite.T(), deleted)
 }
 
 func (suite *SingleLinkedListTestSuite) TestEmptyListIsEmpty() {
@@ -0,0 +1,76 @@
+// Package linkedlist implements the Linked List Data Structure.
+package linkedlist
+
+// SortFunc sorts the list in place with a stable merge sort, ordering data by cmp, which
+// returns a negative number when a < b, zero when they are equal and a positive number
+// when a > b. Nodes are relinked rather than copied.
+func (list *Single[T]) SortFunc(cmp func(a, b T) int) {
+	list.Head = mergeSort(list.Head, list.len, cmp)
+
+	for node := list.Head; node != nil; node = node.Next {
+		list.Tail = node
+	}
+}
+
+// SortFunc sorts the list in place with a stable merge sort, ordering data by cmp, which
+// returns a negative number when a < b, zero when they are equal and a positive number
+// when a > b. Nodes are relinked rather than copied, so node handles stay valid.
+func (list *Double[T]) SortFunc(cmp func(a, b T) int) {
+	list.Head = mergeSort(list.Head, list.len, cmp)
+
+	// The sort only maintains Next, so rebuild Prev and find the new tail.
+	var prev *ListNode[T]
+
+	for node := list.Head; node != nil; node = node.Next {
+		node.Prev = prev
+		prev = node
+	}
+
+	list.Tail = prev
+}
+
+// mergeSort sorts the chain of n nodes starting at head by their Next pointers and returns
+// the new head. Only Next pointers are updated.
+func mergeSort[T comparable](head *ListNode[T], n int, cmp func(a, b T) int) *ListNode[T] {
+	if n <= 1 {
+		return head
+	}
+
+	last := head
+	for i := 1; i < n/2; i++ {
+		last = last.Next
+	}
+
+	right := last.Next
+	last.Next = nil
+
+	return merge(mergeSort(head, n/2, cmp), mergeSort(right, n-n/2, cmp), cmp)
+}
+
+// merge merges two sorted chains and returns the head of the result. On ties the node from
+// left comes first, which keeps the sort stable.
+func merge[T comparable](left, right *ListNode[T], cmp func(a, b T) int) *ListNode[T] {
+	var dummy ListNode[T]
+
+	tail := &dummy
+
+	for left != nil && right != nil {
+		if cmp(left.Data, right.Data) <= 0 {
+			tail.Next = left
+			left = left.Next
+		} else {
+			tail.Next = right
+			right = right.Next
+		}
+
+		tail = tail.Next
+	}
+
+	if left != nil {
+		tail.Next = left
+	} else {
+		tail.Next = right
+	}
+
+	return dummy.Next
+}
@@ -0,0 +1,81 @@
+package linkedlist_test
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/linkedlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// entry is a value whose order is decided by key alone, so stability can be observed.
+type entry struct {
+	key, seq int
+}
+
+// compareEntries orders entries by key only.
+func compareEntries(a, b entry) int {
+	return cmp.Compare(a.key, b.key)
+}
+
+// sortableList is a list that can be sorted and checked.
+type sortableList interface {
+	linkedlist.List[entry]
+	SortFunc(cmp func(a, b entry) int)
+	CheckInvariants() error
+}
+
+func TestSingleSortFunc(t *testing.T) {
+	runSortModel(t, func() sortableList { return linkedlist.NewSingleEmpty[entry]() })
+}
+
+func TestDoubleSortFunc(t *testing.T) {
+	runSortModel(t, func() sortableList { return linkedlist.NewDoubleEmpty[entry]() })
+}
+
+// runSortModel sorts lists of many lengths with few distinct keys and compares them
+// against slices.SortStableFunc.
+func runSortModel(t *testing.T, newList func() sortableList) {
+	t.Helper()
+
+	rng := rand.New(rand.NewPCG(1, 1))
+
+	for length := range 100 {
+		list := newList()
+		model := make([]entry, 0, length)
+
+		for seq := range length {
+			value := entry{key: rng.IntN(10), seq: seq}
+			list.InsertTail(value)
+			model = append(model, value)
+		}
+
+		list.SortFunc(compareEntries)
+		slices.SortStableFunc(model, compareEntries)
+
+		require.NoError(t, list.CheckInvariants(), "length %d", length)
+
+		actual := []entry{}
+		for node := list.GetHead(); node != nil; node = node.Next {
+			actual = append(actual, node.Data)
+		}
+
+		require.Equal(t, model, actual, "length %d", length)
+	}
+}
+
+func TestSortFuncKeepsNodeHandles(t *testing.T) {
+	list := linkedlist.NewDoubleEmpty[int]()
+	three := list.InsertTailNode(3)
+	list.InsertTailNode(1)
+	list.InsertTailNode(2)
+
+	list.SortFunc(cmp.Compare[int])
+
+	require.NoError(t, list.MoveToFront(three))
+	assert.Equal(t, []int{3, 1, 2}, listValues(list))
+	assert.NoError(t, list.CheckInvariants())
+}
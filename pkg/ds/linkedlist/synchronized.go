@@ -0,0 +1,217 @@
+// Package linkedlist implements the Linked List Data Structure.
+package linkedlist
+
+import (
+	"iter"
+	"sync"
+)
+
+// Synchronized satisfies the sequence interface.
+var _ Sequence[int] = (*Synchronized[int])(nil)
+
+// Synchronized represents a singly or doubly linked list that is safe for concurrent use.
+// Mutex ensures the implementation of Synchronized is thread-safe. It never hands out
+// nodes, since a node outlives the lock that guards it; every method works with data instead.
+type Synchronized[T comparable] struct {
+	list searchableList[T]
+	mu   sync.Mutex
+}
+
+// searchableList is a List that can also be searched and iterated by data. Single and Double
+// satisfy it.
+type searchableList[T comparable] interface {
+	List[T]
+	All() iter.Seq[T]
+	Get(position int) (T, error)
+	Set(position int, data T) error
+	IndexOf(data T) int
+	Find(predicate func(T) bool) (T, bool)
+	RemoveIf(predicate func(T) bool) int
+}
+
+// NewSynchronized creates a new, empty doubly linked list that is safe for concurrent use.
+func NewSynchronized[T comparable]() *Synchronized[T] {
+	return &Synchronized[T]{
+		list: NewDoubleEmpty[T](),
+	}
+}
+
+// NewSynchronizedSingle creates a new, empty singly linked list that is safe for concurrent
+// use. It takes less memory per element than NewSynchronized, but DeleteTail takes O(n).
+func NewSynchronizedSingle[T comparable]() *Synchronized[T] {
+	return &Synchronized[T]{
+		list: NewSingleEmpty[T](),
+	}
+}
+
+// ClearList clears the Linked List.
+func (s *Synchronized[T]) ClearList() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.list.ClearList()
+}
+
+// DeleteAtPosition deletes the node at the specified position and returns its data.
+func (s *Synchronized[T]) DeleteAtPosition(position int) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.DeleteAtPosition(position)
+}
+
+// DeleteHead deletes the head node.
+func (s *Synchronized[T]) DeleteHead() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.DeleteHead()
+}
+
+// DeleteTail deletes the tail node.
+func (s *Synchronized[T]) DeleteTail() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.DeleteTail()
+}
+
+// InsertAtPosition inserts a node at the specified position.
+func (s *Synchronized[T]) InsertAtPosition(position int, data T) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.InsertAtPosition(position, data)
+}
+
+// InsertHead inserts a node at the head of the list.
+func (s *Synchronized[T]) InsertHead(data T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.InsertHead(data)
+}
+
+// InsertTail inserts a node at the tail of the list.
+func (s *Synchronized[T]) InsertTail(data T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.InsertTail(data)
+}
+
+// Head returns the data at the head of the list. It returns false if the list is empty.
+func (s *Synchronized[T]) Head() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return nodeData(s.list.GetHead())
+}
+
+// Tail returns the data at the tail of the list. It returns false if the list is empty.
+func (s *Synchronized[T]) Tail() (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return nodeData(s.list.GetTail())
+}
+
+// Get returns the data at the position.
+func (s *Synchronized[T]) Get(position int) (T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.Get(position)
+}
+
+// Set replaces the data at the position.
+func (s *Synchronized[T]) Set(position int, data T) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.Set(position, data)
+}
+
+// IndexOf returns the position of the first node holding the data, or -1 if there is none.
+func (s *Synchronized[T]) IndexOf(data T) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.IndexOf(data)
+}
+
+// IsValuePresent checks if a value is present in the list.
+func (s *Synchronized[T]) IsValuePresent(data T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.IsValuePresent(data)
+}
+
+// Find returns the data of the first node that satisfies the predicate.
+// The predicate runs under the lock, so it must not call back into the list.
+func (s *Synchronized[T]) Find(predicate func(T) bool) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.Find(predicate)
+}
+
+// RemoveIf removes every node that satisfies the predicate and returns how many were removed.
+// The predicate runs under the lock, so it must not call back into the list.
+func (s *Synchronized[T]) RemoveIf(predicate func(T) bool) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.RemoveIf(predicate)
+}
+
+// IsEmpty returns whether the linked list is empty.
+func (s *Synchronized[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Len retrieves the length of the linked list.
+func (s *Synchronized[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.list.Len()
+}
+
+// All returns an iterator over the data in the list from head to tail.
+// The data is copied under the lock when iteration starts, so the list may be modified
+// while iterating without affecting the iteration.
+func (s *Synchronized[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, value := range s.ToSlice() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns a copy of the data in the list from head to tail.
+func (s *Synchronized[T]) ToSlice() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	values := make([]T, 0, s.list.Len())
+	for value := range s.list.All() {
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// nodeData returns the data of the node, or false if the node is nil.
+func nodeData[T comparable](node *ListNode[T]) (T, bool) {
+	if node == nil {
+		var zero T
+
+		return zero, false
+	}
+
+	return node.Data, true
+}
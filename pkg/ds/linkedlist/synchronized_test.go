@@ -0,0 +1,218 @@
+package linkedlist_test
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/linkedlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// SynchronizedTestSuite runs against a Synchronized list wrapping each kind of list.
+type SynchronizedTestSuite struct {
+	suite.Suite
+	newList func() *linkedlist.Synchronized[int]
+	list    *linkedlist.Synchronized[int]
+}
+
+func TestSynchronizedTestSuite(t *testing.T) {
+	suite.Run(t, &SynchronizedTestSuite{newList: linkedlist.NewSynchronized[int]})
+}
+
+func TestSynchronizedSingleTestSuite(t *testing.T) {
+	suite.Run(t, &SynchronizedTestSuite{newList: linkedlist.NewSynchronizedSingle[int]})
+}
+
+func (suite *SynchronizedTestSuite) SetupTest() {
+	suite.list = suite.newList()
+}
+
+func (suite *SynchronizedTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.list.CheckInvariants())
+}
+
+func (suite *SynchronizedTestSuite) TestEmpty() {
+	assert.True(suite.T(), suite.list.IsEmpty())
+
+	head, ok := suite.list.Head()
+	assert.False(suite.T(), ok)
+	assert.Zero(suite.T(), head)
+
+	tail, ok := suite.list.Tail()
+	assert.False(suite.T(), ok)
+	assert.Zero(suite.T(), tail)
+
+	assert.Empty(suite.T(), suite.list.ToSlice())
+}
+
+func (suite *SynchronizedTestSuite) TestInsertAndDelete() {
+	suite.list.InsertTail(2)
+	suite.list.InsertHead(1)
+
+	_, err := suite.list.InsertAtPosition(2, 3)
+	require.NoError(suite.T(), err)
+
+	head, _ := suite.list.Head()
+	tail, _ := suite.list.Tail()
+	assert.Equal(suite.T(), 1, head)
+	assert.Equal(suite.T(), 3, tail)
+
+	value, err := suite.list.DeleteAtPosition(1)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 2, value)
+
+	value, _ = suite.list.DeleteHead()
+	assert.Equal(suite.T(), 1, value)
+
+	value, _ = suite.list.DeleteTail()
+	assert.Equal(suite.T(), 3, value)
+	assert.True(suite.T(), suite.list.IsEmpty())
+}
+
+func (suite *SynchronizedTestSuite) TestInvalidPositions() {
+	_, err := suite.list.InsertAtPosition(1, 1)
+	assert.ErrorIs(suite.T(), err, linkedlist.ErrInvalidPosition)
+
+	_, err = suite.list.DeleteAtPosition(0)
+	assert.ErrorIs(suite.T(), err, linkedlist.ErrInvalidPosition)
+
+	_, err = suite.list.Get(0)
+	assert.ErrorIs(suite.T(), err, linkedlist.ErrInvalidPosition)
+
+	assert.ErrorIs(suite.T(), suite.list.Set(0, 1), linkedlist.ErrInvalidPosition)
+}
+
+func (suite *SynchronizedTestSuite) TestSearch() {
+	for _, value := range []int{1, 2, 3, 2} {
+		suite.list.InsertTail(value)
+	}
+
+	require.NoError(suite.T(), suite.list.Set(0, 5))
+
+	value, err := suite.list.Get(0)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 5, value)
+
+	assert.Equal(suite.T(), 1, suite.list.IndexOf(2))
+	assert.True(suite.T(), suite.list.IsValuePresent(3))
+
+	found, ok := suite.list.Find(func(value int) bool { return value > 2 })
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), 5, found)
+
+	assert.Equal(suite.T(), 2, suite.list.RemoveIf(func(value int) bool { return value == 2 }))
+	assert.Equal(suite.T(), []int{5, 3}, suite.list.ToSlice())
+
+	suite.list.ClearList()
+	assert.Equal(suite.T(), 0, suite.list.Len())
+}
+
+func (suite *SynchronizedTestSuite) TestAllIsASnapshot() {
+	suite.list.InsertTail(1)
+	suite.list.InsertTail(2)
+
+	var visited []int
+
+	// Modifying the list while iterating must neither deadlock nor change the iteration.
+	for value := range suite.list.All() {
+		suite.list.InsertTail(value * 10)
+		visited = append(visited, value)
+	}
+
+	assert.Equal(suite.T(), []int{1, 2}, visited)
+	assert.Equal(suite.T(), []int{1, 2, 10, 20}, suite.list.ToSlice())
+}
+
+func (suite *SynchronizedTestSuite) TestToSliceIsACopy() {
+	suite.list.InsertTail(1)
+
+	values := suite.list.ToSlice()
+	values[0] = 9
+
+	assert.Equal(suite.T(), []int{1}, suite.list.ToSlice())
+}
+
+func (suite *SynchronizedTestSuite) TestConcurrentAccess() {
+	goroutines := 8
+	perGoroutine := 500
+
+	var wg sync.WaitGroup
+
+	for g := range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			// Each goroutine inserts its own range at both ends and reads while others write.
+			start := g * perGoroutine
+			for i := start; i < start+perGoroutine; i++ {
+				if i%2 == 0 {
+					suite.list.InsertHead(i)
+				} else {
+					suite.list.InsertTail(i)
+				}
+
+				suite.list.IsValuePresent(i)
+				_, _ = suite.list.Get(suite.list.Len() / 2)
+
+				for range suite.list.All() {
+					break
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	values := suite.list.ToSlice()
+	slices.Sort(values)
+
+	expected := make([]int, goroutines*perGoroutine)
+	for i := range expected {
+		expected[i] = i
+	}
+
+	assert.Equal(suite.T(), expected, values)
+}
+
+func (suite *SynchronizedTestSuite) TestConcurrentInsertAndDelete() {
+	goroutines := 8
+	perGoroutine := 500
+
+	var (
+		wg      sync.WaitGroup
+		deleted atomic.Int64
+	)
+
+	for range goroutines {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range perGoroutine {
+				suite.list.InsertTail(i)
+			}
+		}()
+
+		go func() {
+			defer wg.Done()
+
+			for range perGoroutine {
+				if _, ok := suite.list.DeleteHead(); ok {
+					deleted.Add(1)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Every inserted value is either still in the list or was deleted.
+	assert.Equal(suite.T(), int64(goroutines*perGoroutine), int64(suite.list.Len())+deleted.Load())
+}
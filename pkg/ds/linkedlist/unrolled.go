@@ -0,0 +1,366 @@
+// Package linkedlist implements the Linked List Data Structure.
+package linkedlist
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// DefaultNodeCapacity is the number of elements each node of a list made with NewUnrolled holds
+// to begin with. The capacity grows as the list does and never shrinks below this.
+const DefaultNodeCapacity = 64
+
+// minNodeCapacity is the smallest node capacity that leaves room to split a full node.
+const minNodeCapacity = 2
+
+// Unrolled satisfies the sequence interface.
+var _ Sequence[int] = (*Unrolled[int])(nil)
+
+// Unrolled represents an unrolled linked list: a doubly linked list whose nodes each hold up
+// to capacity elements in an array. Scanning touches far fewer nodes, and far less memory for
+// the garbage collector, than a list with one node per element.
+// Every node except the head and tail is kept at least half full. A list made with NewUnrolled
+// keeps its capacity within a small factor of the square root of its length, so indexed access
+// walks O(sqrt n) nodes; one made with NewUnrolledWithCapacity keeps its capacity fixed.
+type Unrolled[T comparable] struct {
+	head, tail *unrolledNode[T]
+	len        int
+	capacity   int  // The most elements a node holds.
+	adaptive   bool // Whether capacity follows the square root of len.
+}
+
+// unrolledNode holds up to capacity elements of an Unrolled list.
+type unrolledNode[T comparable] struct {
+	items      []T
+	prev, next *unrolledNode[T]
+}
+
+// NewUnrolled creates a new unrolled linked list whose nodes hold DefaultNodeCapacity elements
+// until it grows past DefaultNodeCapacity squared, after which they hold about sqrt(n).
+func NewUnrolled[T comparable]() *Unrolled[T] {
+	return &Unrolled[T]{capacity: DefaultNodeCapacity, adaptive: true}
+}
+
+// NewUnrolledWithCapacity creates a new unrolled linked list whose nodes hold up to capacity
+// elements however long it grows. The capacity must be at least 2.
+func NewUnrolledWithCapacity[T comparable](capacity int) (*Unrolled[T], error) {
+	if capacity < minNodeCapacity {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidCapacity, capacity)
+	}
+
+	return &Unrolled[T]{capacity: capacity}, nil
+}
+
+// ClearList clears the Linked List.
+func (list *Unrolled[T]) ClearList() {
+	list.head = nil
+	list.tail = nil
+	list.len = 0
+
+	if list.adaptive {
+		list.capacity = DefaultNodeCapacity
+	}
+}
+
+// DeleteAtPosition deletes the element at the specified position and returns it.
+func (list *Unrolled[T]) DeleteAtPosition(position int) (T, error) {
+	if position < 0 || position >= list.len {
+		var zero T
+
+		return zero, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	node, offset := list.locate(position)
+
+	return list.deleteAt(node, offset), nil
+}
+
+// DeleteHead deletes the element at the head of the list.
+func (list *Unrolled[T]) DeleteHead() (T, bool) {
+	if list.IsEmpty() {
+		var zero T
+
+		return zero, false
+	}
+
+	return list.deleteAt(list.head, 0), true
+}
+
+// DeleteTail deletes the element at the tail of the list.
+func (list *Unrolled[T]) DeleteTail() (T, bool) {
+	if list.IsEmpty() {
+		var zero T
+
+		return zero, false
+	}
+
+	return list.deleteAt(list.tail, len(list.tail.items)-1), true
+}
+
+// InsertAtPosition inserts an element at the specified position.
+func (list *Unrolled[T]) InsertAtPosition(position int, data T) (bool, error) {
+	if position < 0 || position > list.len {
+		return false, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	if position == list.len {
+		return list.InsertTail(data), nil
+	}
+
+	node, offset := list.locate(position)
+	list.insertAt(node, offset, data)
+
+	return true, nil
+}
+
+// InsertHead inserts an element at the head of the list.
+func (list *Unrolled[T]) InsertHead(data T) bool {
+	if list.head == nil || len(list.head.items) == list.capacity {
+		list.linkAfter(nil)
+	}
+
+	list.head.items = slices.Insert(list.head.items, 0, data)
+	list.len++
+	list.resize()
+
+	return true
+}
+
+// InsertTail inserts an element at the tail of the list in amortized O(1).
+func (list *Unrolled[T]) InsertTail(data T) bool {
+	if list.tail == nil || len(list.tail.items) == list.capacity {
+		list.linkAfter(list.tail)
+	}
+
+	list.tail.items = append(list.tail.items, data)
+	list.len++
+	list.resize()
+
+	return true
+}
+
+// Get returns the element at the position, walking from the nearer end.
+func (list *Unrolled[T]) Get(position int) (T, error) {
+	if position < 0 || position >= list.len {
+		var zero T
+
+		return zero, fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	node, offset := list.locate(position)
+
+	return node.items[offset], nil
+}
+
+// Set replaces the element at the position, walking from the nearer end.
+func (list *Unrolled[T]) Set(position int, data T) error {
+	if position < 0 || position >= list.len {
+		return fmt.Errorf("%w: %d", ErrInvalidPosition, position)
+	}
+
+	node, offset := list.locate(position)
+	node.items[offset] = data
+
+	return nil
+}
+
+// IndexOf returns the position of the first element equal to the data, or -1 if there is none.
+func (list *Unrolled[T]) IndexOf(data T) int {
+	position := 0
+
+	for node := list.head; node != nil; node = node.next {
+		if offset := slices.Index(node.items, data); offset >= 0 {
+			return position + offset
+		}
+
+		position += len(node.items)
+	}
+
+	return -1
+}
+
+// IsEmpty returns whether the linked list is empty.
+func (list *Unrolled[T]) IsEmpty() bool {
+	return list.Len() == 0
+}
+
+// IsValuePresent checks if a value is present in the list.
+func (list *Unrolled[T]) IsValuePresent(data T) bool {
+	return list.IndexOf(data) >= 0
+}
+
+// Len retrieves the length of the linked list.
+func (list *Unrolled[T]) Len() int {
+	return list.len
+}
+
+// All returns an iterator over the elements in the list from head to tail.
+// The list must not be modified while iterating.
+func (list *Unrolled[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for node := list.head; node != nil; node = node.next {
+			for _, item := range node.items {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// locate returns the node holding a position that holds an element and the element's offset
+// in it, walking from the nearer end.
+func (list *Unrolled[T]) locate(position int) (*unrolledNode[T], int) {
+	if position < list.len/2 {
+		node := list.head
+		for position >= len(node.items) {
+			position -= len(node.items)
+			node = node.next
+		}
+
+		return node, position
+	}
+
+	// Count back from the end instead.
+	fromEnd := list.len - 1 - position
+
+	node := list.tail
+	for fromEnd >= len(node.items) {
+		fromEnd -= len(node.items)
+		node = node.prev
+	}
+
+	return node, len(node.items) - 1 - fromEnd
+}
+
+// insertAt inserts the data at the offset in the node, splitting the node first if it is full.
+func (list *Unrolled[T]) insertAt(node *unrolledNode[T], offset int, data T) {
+	if len(node.items) == list.capacity {
+		// Move the back half into a new node after this one.
+		half := list.capacity / 2
+		next := list.linkAfter(node)
+		next.items = append(next.items, node.items[half:]...)
+		clear(node.items[half:])
+		node.items = node.items[:half]
+
+		if offset > half {
+			node, offset = next, offset-half
+		}
+	}
+
+	node.items = slices.Insert(node.items, offset, data)
+	list.len++
+	list.resize()
+}
+
+// deleteAt removes and returns the element at the offset in the node. A node that becomes
+// empty is unlinked, and one that drops below half full is refilled from the next node.
+func (list *Unrolled[T]) deleteAt(node *unrolledNode[T], offset int) T {
+	// Resize once the nodes are back in shape.
+	defer list.resize()
+
+	data := node.items[offset]
+	node.items = slices.Delete(node.items, offset, offset+1)
+	list.len--
+
+	if len(node.items) == 0 {
+		list.unlink(node)
+
+		return data
+	}
+
+	next := node.next
+	if len(node.items) >= list.capacity/2 || next == nil {
+		return data
+	}
+
+	if len(node.items)+len(next.items) <= list.capacity {
+		// Merge the next node into this one.
+		node.items = append(node.items, next.items...)
+		list.unlink(next)
+
+		return data
+	}
+
+	// Borrow from the next node, which stays at least half full since both can't fit in one.
+	borrow := list.capacity/2 - len(node.items)
+	node.items = append(node.items, next.items[:borrow]...)
+	next.items = slices.Delete(next.items, 0, borrow)
+
+	return data
+}
+
+// resize keeps the capacity of an adaptive list between sqrt(len) and 4*sqrt(len), so a walk
+// to a position passes O(sqrt n) nodes and a node shifts O(sqrt n) elements. The capacity
+// doubles once len passes its square and halves once len drops below a sixteenth of it.
+// Repacking takes O(n), but len must change by a constant factor between repacks, so it
+// adds O(1) amortized to each insert and delete.
+func (list *Unrolled[T]) resize() {
+	if !list.adaptive {
+		return
+	}
+
+	switch {
+	case list.len > list.capacity*list.capacity:
+		list.repack(list.capacity * 2)
+	case list.capacity > DefaultNodeCapacity && list.len < list.capacity*list.capacity/16:
+		list.repack(list.capacity / 2)
+	}
+}
+
+// repack moves the elements into full nodes of the new capacity.
+func (list *Unrolled[T]) repack(capacity int) {
+	items := slices.Collect(list.All())
+
+	list.head = nil
+	list.tail = nil
+	list.capacity = capacity
+
+	for chunk := range slices.Chunk(items, capacity) {
+		node := list.linkAfter(list.tail)
+		node.items = append(node.items, chunk...)
+	}
+}
+
+// linkAfter links a new, empty node after prev, or at the head if prev is nil, and returns it.
+func (list *Unrolled[T]) linkAfter(prev *unrolledNode[T]) *unrolledNode[T] {
+	node := &unrolledNode[T]{
+		items: make([]T, 0, list.capacity),
+		prev:  prev,
+	}
+
+	if prev == nil {
+		node.next = list.head
+		list.head = node
+	} else {
+		node.next = prev.next
+		prev.next = node
+	}
+
+	if node.next == nil {
+		list.tail = node
+	} else {
+		node.next.prev = node
+	}
+
+	return node
+}
+
+// unlink unlinks the node from the list.
+func (list *Unrolled[T]) unlink(node *unrolledNode[T]) {
+	if node.prev == nil {
+		list.head = node.next
+	} else {
+		node.prev.next = node.next
+	}
+
+	if node.next == nil {
+		list.tail = node.prev
+	} else {
+		node.next.prev = node.prev
+	}
+
+	node.next = nil
+	node.prev = nil
+}
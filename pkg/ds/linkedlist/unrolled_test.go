@@ -0,0 +1,314 @@
+package linkedlist_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/linkedlist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type UnrolledTestSuite struct {
+	suite.Suite
+	list *linkedlist.Unrolled[int]
+}
+
+func TestUnrolledTestSuite(t *testing.T) {
+	suite.Run(t, new(UnrolledTestSuite))
+}
+
+func (suite *UnrolledTestSuite) SetupTest() {
+	list, err := linkedlist.NewUnrolledWithCapacity[int](4)
+	require.NoError(suite.T(), err)
+
+	suite.list = list
+}
+
+func (suite *UnrolledTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.list.CheckInvariants())
+}
+
+// fill appends the values 0 to n-1.
+func (suite *UnrolledTestSuite) fill(n int) {
+	for i := range n {
+		suite.list.InsertTail(i)
+	}
+}
+
+func (suite *UnrolledTestSuite) TestNewUnrolled() {
+	list := linkedlist.NewUnrolled[string]()
+
+	assert.True(suite.T(), list.IsEmpty())
+	assert.NoError(suite.T(), list.CheckInvariants())
+}
+
+func (suite *UnrolledTestSuite) TestInvalidCapacity() {
+	for _, capacity := range []int{-1, 0, 1} {
+		list, err := linkedlist.NewUnrolledWithCapacity[int](capacity)
+
+		assert.Nil(suite.T(), list)
+		assert.ErrorIs(suite.T(), err, linkedlist.ErrInvalidCapacity)
+	}
+}
+
+func (suite *UnrolledTestSuite) TestInsertTailSpansNodes() {
+	suite.fill(10)
+
+	assert.Equal(suite.T(), 10, suite.list.Len())
+	assert.Equal(suite.T(), []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, slices.Collect(suite.list.All()))
+}
+
+func (suite *UnrolledTestSuite) TestInsertHead() {
+	for i := range 6 {
+		suite.list.InsertHead(i)
+	}
+
+	assert.Equal(suite.T(), []int{5, 4, 3, 2, 1, 0}, slices.Collect(suite.list.All()))
+}
+
+func (suite *UnrolledTestSuite) TestInsertIntoFullNodeSplits() {
+	suite.fill(4)
+
+	inserted, err := suite.list.InsertAtPosition(3, 9)
+
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), inserted)
+	assert.Equal(suite.T(), []int{0, 1, 2, 9, 3}, slices.Collect(suite.list.All()))
+}
+
+func (suite *UnrolledTestSuite) TestDeleteMergesNodes() {
+	suite.fill(8)
+
+	for range 5 {
+		_, err := suite.list.DeleteAtPosition(1)
+		require.NoError(suite.T(), err)
+		require.NoError(suite.T(), suite.list.CheckInvariants())
+	}
+
+	assert.Equal(suite.T(), []int{0, 6, 7}, slices.Collect(suite.list.All()))
+}
+
+func (suite *UnrolledTestSuite) TestDeleteHeadAndTail() {
+	suite.fill(6)
+
+	head, ok := suite.list.DeleteHead()
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), 0, head)
+
+	tail, ok := suite.list.DeleteTail()
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), 5, tail)
+
+	suite.list.ClearList()
+
+	_, ok = suite.list.DeleteHead()
+	assert.False(suite.T(), ok)
+
+	_, ok = suite.list.DeleteTail()
+	assert.False(suite.T(), ok)
+}
+
+func (suite *UnrolledTestSuite) TestGetAndSet() {
+	suite.fill(10)
+
+	// Check every position so both ends are walked.
+	for position := range 10 {
+		require.NoError(suite.T(), suite.list.Set(position, position*10))
+
+		value, err := suite.list.Get(position)
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), position*10, value)
+	}
+
+	_, err := suite.list.Get(10)
+	assert.ErrorIs(suite.T(), err, linkedlist.ErrInvalidPosition)
+	assert.ErrorIs(suite.T(), suite.list.Set(-1, 0), linkedlist.ErrInvalidPosition)
+}
+
+func (suite *UnrolledTestSuite) TestIndexOf() {
+	suite.fill(10)
+
+	assert.Equal(suite.T(), 7, suite.list.IndexOf(7))
+	assert.Equal(suite.T(), -1, suite.list.IndexOf(10))
+	assert.True(suite.T(), suite.list.IsValuePresent(0))
+	assert.False(suite.T(), suite.list.IsValuePresent(-1))
+}
+
+func (suite *UnrolledTestSuite) TestAllStopsEarly() {
+	suite.fill(10)
+
+	var visited []int
+
+	for value := range suite.list.All() {
+		visited = append(visited, value)
+		if value == 5 {
+			break
+		}
+	}
+
+	assert.Equal(suite.T(), []int{0, 1, 2, 3, 4, 5}, visited)
+}
+
+func (suite *UnrolledTestSuite) TestCapacityFollowsSquareRoot() {
+	list := linkedlist.NewUnrolled[int]()
+	model := []int{}
+
+	// Insert at the tail, head and middle so every insert path grows the capacity.
+	for i := range 20_000 {
+		switch i % 3 {
+		case 0:
+			list.InsertTail(i)
+			model = append(model, i)
+		case 1:
+			list.InsertHead(i)
+			model = slices.Insert(model, 0, i)
+		default:
+			_, err := list.InsertAtPosition(len(model)/2, i)
+			require.NoError(suite.T(), err)
+
+			model = slices.Insert(model, len(model)/2, i)
+		}
+	}
+
+	// 20,000 is past 128 squared but not 256 squared.
+	assert.Equal(suite.T(), 256, list.NodeCapacity())
+	require.NoError(suite.T(), list.CheckInvariants())
+	assert.Equal(suite.T(), model, slices.Collect(list.All()))
+
+	for len(model) > 500 {
+		switch len(model) % 3 {
+		case 0:
+			_, _ = list.DeleteTail()
+			model = model[:len(model)-1]
+		case 1:
+			_, _ = list.DeleteHead()
+			model = model[1:]
+		default:
+			_, err := list.DeleteAtPosition(len(model) / 2)
+			require.NoError(suite.T(), err)
+
+			model = slices.Delete(model, len(model)/2, len(model)/2+1)
+		}
+	}
+
+	assert.Equal(suite.T(), linkedlist.DefaultNodeCapacity, list.NodeCapacity())
+	require.NoError(suite.T(), list.CheckInvariants())
+	assert.Equal(suite.T(), model, slices.Collect(list.All()))
+
+	list.ClearList()
+	assert.Equal(suite.T(), linkedlist.DefaultNodeCapacity, list.NodeCapacity())
+}
+
+func (suite *UnrolledTestSuite) TestFixedCapacityDoesNotGrow() {
+	suite.fill(1000)
+
+	assert.Equal(suite.T(), 4, suite.list.NodeCapacity())
+}
+
+// benchmarkSize is the number of elements in the benchmarked lists.
+const benchmarkSize = 10_000
+
+func BenchmarkAppend(b *testing.B) {
+	b.Run("Unrolled", func(b *testing.B) {
+		for range b.N {
+			list := linkedlist.NewUnrolled[int]()
+			for i := range benchmarkSize {
+				list.InsertTail(i)
+			}
+		}
+	})
+
+	b.Run("Double", func(b *testing.B) {
+		for range b.N {
+			list := linkedlist.NewDoubleEmpty[int]()
+			for i := range benchmarkSize {
+				list.InsertTail(i)
+			}
+		}
+	})
+
+	b.Run("Slice", func(b *testing.B) {
+		for range b.N {
+			var values []int
+			for i := range benchmarkSize {
+				values = append(values, i)
+			}
+
+			_ = values
+		}
+	})
+}
+
+func BenchmarkIterate(b *testing.B) {
+	unrolled := linkedlist.NewUnrolled[int]()
+	double := linkedlist.NewDoubleEmpty[int]()
+	values := make([]int, 0, benchmarkSize)
+
+	for i := range benchmarkSize {
+		unrolled.InsertTail(i)
+		double.InsertTail(i)
+		values = append(values, i)
+	}
+
+	b.Run("Unrolled", func(b *testing.B) {
+		for range b.N {
+			sum := 0
+			for value := range unrolled.All() {
+				sum += value
+			}
+		}
+	})
+
+	b.Run("Double", func(b *testing.B) {
+		for range b.N {
+			sum := 0
+			for value := range double.All() {
+				sum += value
+			}
+		}
+	})
+
+	b.Run("Slice", func(b *testing.B) {
+		for range b.N {
+			sum := 0
+			for _, value := range values {
+				sum += value
+			}
+		}
+	})
+}
+
+func BenchmarkGet(b *testing.B) {
+	unrolled := linkedlist.NewUnrolled[int]()
+	double := linkedlist.NewDoubleEmpty[int]()
+	values := make([]int, 0, benchmarkSize)
+
+	for i := range benchmarkSize {
+		unrolled.InsertTail(i)
+		double.InsertTail(i)
+		values = append(values, i)
+	}
+
+	// The middle is the farthest position from either end.
+	middle := benchmarkSize / 2
+
+	b.Run("Unrolled", func(b *testing.B) {
+		for range b.N {
+			_, _ = unrolled.Get(middle)
+		}
+	})
+
+	b.Run("Double", func(b *testing.B) {
+		for range b.N {
+			_, _ = double.Get(middle)
+		}
+	})
+
+	b.Run("Slice", func(b *testing.B) {
+		for range b.N {
+			_ = values[middle]
+		}
+	})
+}
@@ -0,0 +1,52 @@
+package persistent
+
+import (
+	"errors"
+	"fmt"
+)
+
+// errInvariant is an error indicating a queue's internal bookkeeping is inconsistent.
+var errInvariant = errors.New("invariant violated")
+
+// CheckInvariants exposes checkInvariants to the persistent_test package.
+func (q Queue[T]) CheckInvariants() error {
+	return q.checkInvariants()
+}
+
+// checkInvariants verifies that rear is no longer than front and that schedule is the
+// suffix of front after its first rear.len cells, all of which are evaluated. That is what
+// keeps the next rotation from having to evaluate more than one cell per operation.
+func (q Queue[T]) checkInvariants() error {
+	rearLen := 0
+	if q.rear != nil {
+		rearLen = q.rear.len
+	}
+
+	if q.frontLen < rearLen {
+		return fmt.Errorf("%w: rear holds %d items, front only %d", errInvariant, rearLen, q.frontLen)
+	}
+
+	current := q.front
+
+	for evaluated := range rearLen {
+		if current == nil || !current.evaluated() {
+			return fmt.Errorf("%w: cell %d of front isn't evaluated", errInvariant, evaluated)
+		}
+
+		_, current = current.force()
+	}
+
+	if current != q.schedule {
+		return fmt.Errorf("%w: schedule doesn't start %d cells into front", errInvariant, rearLen)
+	}
+
+	return nil
+}
+
+// evaluated returns true if the stream has been forced.
+func (s *stream[T]) evaluated() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.suspended == nil
+}
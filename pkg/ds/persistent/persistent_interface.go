@@ -0,0 +1,32 @@
+// Package persistent implements immutable data structures that share structure between versions.
+package persistent
+
+import "errors"
+
+var (
+	// ErrEmptyStack is an error indicating the stack is empty when attempting to
+	// peek at or remove the element on the top of the stack.
+	ErrEmptyStack = errors.New("empty stack")
+
+	// ErrEmptyQueue is an error indicating that the queue is empty when attempting to
+	// peek at or dequeue an item from the queue.
+	ErrEmptyQueue = errors.New("empty queue")
+)
+
+// cell is an immutable cons cell. Cells are shared between versions and never modified
+// once they are reachable from one.
+type cell[T comparable] struct {
+	value T
+	next  *cell[T]
+	len   int // The number of cells from this one to the end of the list.
+}
+
+// cons returns a new cell holding the value in front of next.
+func cons[T comparable](value T, next *cell[T]) *cell[T] {
+	length := 1
+	if next != nil {
+		length += next.len
+	}
+
+	return &cell[T]{value: value, next: next, len: length}
+}
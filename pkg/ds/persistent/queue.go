@@ -0,0 +1,159 @@
+// Package persistent implements immutable data structures that share structure between versions.
+package persistent
+
+import (
+	"iter"
+	"sync"
+)
+
+// Queue represents an immutable FIFO queue. Enqueue and Dequeue return a new version and
+// leave the old one untouched, so versions are cheap to keep and safe to pass between
+// goroutines without locks. The zero value is an empty queue.
+//
+// The queue is Okasaki's real-time queue. front is a lazy stream in dequeue order and rear
+// holds enqueued items newest first. When rear grows longer than front, front is replaced by
+// a suspended rotation that appends the reversed rear to it one cell at a time. schedule
+// points at the first cell of front that hasn't been evaluated, and every operation
+// evaluates one more. That finishes the rotation before the next one starts, so every
+// operation is O(1) in the worst case, even when an old version is reused many times.
+type Queue[T comparable] struct {
+	front *stream[T]
+	// frontLen is the number of items in front, which is never less than rear.len.
+	frontLen int
+	rear     *cell[T]
+	// schedule is the suffix of front still to be evaluated, frontLen - rear.len cells long.
+	schedule *stream[T]
+}
+
+// stream is a lazily evaluated, memoized cell of a list. A nil stream is the empty list.
+// The first force runs suspended and remembers its result, so versions sharing the stream
+// share the work too.
+// Mutex ensures the implementation of stream is thread-safe.
+type stream[T comparable] struct {
+	suspended func() (T, *stream[T])
+	value     T
+	next      *stream[T]
+	mu        sync.Mutex
+}
+
+// NewQueue creates a new, empty queue.
+func NewQueue[T comparable]() Queue[T] {
+	return Queue[T]{}
+}
+
+// Enqueue returns a new version of the queue with the value at the back.
+func (q Queue[T]) Enqueue(value T) Queue[T] {
+	return step(q.front, q.frontLen, cons(value, q.rear), q.schedule)
+}
+
+// Dequeue returns the item at the front of the queue and a new version of the queue without it.
+func (q Queue[T]) Dequeue() (*T, Queue[T], error) {
+	if q.front == nil {
+		return nil, q, ErrEmptyQueue
+	}
+
+	item, next := q.front.force()
+
+	return &item, step(next, q.frontLen-1, q.rear, q.schedule), nil
+}
+
+// Peek returns the item at the front of the queue.
+func (q Queue[T]) Peek() (*T, error) {
+	if q.front == nil {
+		return nil, ErrEmptyQueue
+	}
+
+	item, _ := q.front.force()
+
+	return &item, nil
+}
+
+// IsEmpty returns true if the queue is empty. Otherwise returns false.
+func (q Queue[T]) IsEmpty() bool {
+	return q.front == nil
+}
+
+// Len returns the length of the queue.
+func (q Queue[T]) Len() int {
+	length := q.frontLen
+
+	if q.rear != nil {
+		length += q.rear.len
+	}
+
+	return length
+}
+
+// All returns an iterator over the items in the queue from the front to the back.
+func (q Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for current := q.front; current != nil; {
+			var value T
+
+			value, current = current.force()
+			if !yield(value) {
+				return
+			}
+		}
+
+		for current := reverse(q.rear); current != nil; current = current.next {
+			if !yield(current.value) {
+				return
+			}
+		}
+	}
+}
+
+// step builds the queue that follows an operation. It evaluates the next scheduled cell of
+// front or, once they are all evaluated and rear has outgrown front, starts a new rotation.
+func step[T comparable](front *stream[T], frontLen int, rear *cell[T], schedule *stream[T]) Queue[T] {
+	if schedule != nil {
+		_, next := schedule.force()
+
+		return Queue[T]{front: front, frontLen: frontLen, rear: rear, schedule: next}
+	}
+
+	rotated := rotate(front, rear, nil)
+
+	return Queue[T]{front: rotated, frontLen: frontLen + rear.len, schedule: rotated}
+}
+
+// rotate returns a suspended stream of front followed by rear reversed and then rotated,
+// where rear is one cell longer than front. Evaluating each cell takes O(1), since front has
+// been evaluated by the time a rotation starts.
+func rotate[T comparable](front *stream[T], rear *cell[T], rotated *stream[T]) *stream[T] {
+	return &stream[T]{suspended: func() (T, *stream[T]) {
+		if front == nil {
+			return rear.value, rotated
+		}
+
+		value, next := front.force()
+
+		return value, rotate(next, rear.next, &stream[T]{value: rear.value, next: rotated})
+	}}
+}
+
+// force evaluates the stream on first use and returns its value and the rest of the stream.
+func (s *stream[T]) force() (T, *stream[T]) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.suspended != nil {
+		s.value, s.next = s.suspended()
+		// Drop the suspension so the cells it captured can be collected.
+		s.suspended = nil
+	}
+
+	return s.value, s.next
+}
+
+// reverse returns a new list holding the values of list in reverse order.
+func reverse[T comparable](list *cell[T]) *cell[T] {
+	var reversed *cell[T]
+
+	for current := list; current != nil; current = current.next {
+		reversed = cons(current.value, reversed)
+	}
+
+	return reversed
+}
@@ -0,0 +1,159 @@
+package persistent_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/persistent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type QueueTestSuite struct {
+	suite.Suite
+}
+
+func TestQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(QueueTestSuite))
+}
+
+func (suite *QueueTestSuite) TestZeroValueIsEmpty() {
+	var empty persistent.Queue[int]
+
+	assert.True(suite.T(), empty.IsEmpty())
+	assert.Equal(suite.T(), 0, empty.Len())
+
+	item, err := empty.Peek()
+	assert.Nil(suite.T(), item)
+	assert.ErrorIs(suite.T(), err, persistent.ErrEmptyQueue)
+
+	item, rest, err := empty.Dequeue()
+	assert.Nil(suite.T(), item)
+	assert.True(suite.T(), rest.IsEmpty())
+	assert.ErrorIs(suite.T(), err, persistent.ErrEmptyQueue)
+}
+
+func (suite *QueueTestSuite) TestFIFO() {
+	queue := persistent.NewQueue[int]().Enqueue(1).Enqueue(2).Enqueue(3)
+
+	front, err := queue.Peek()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, *front)
+
+	for _, expected := range []int{1, 2, 3} {
+		var item *int
+
+		item, queue, err = queue.Dequeue()
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), expected, *item)
+	}
+
+	assert.True(suite.T(), queue.IsEmpty())
+}
+
+func (suite *QueueTestSuite) TestOldVersionsAreUnchanged() {
+	base := persistent.NewQueue[int]().Enqueue(1).Enqueue(2)
+	left := base.Enqueue(3)
+	right := base.Enqueue(4)
+	_, dequeued, err := base.Dequeue()
+	require.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), []int{1, 2}, slices.Collect(base.All()))
+	assert.Equal(suite.T(), []int{1, 2, 3}, slices.Collect(left.All()))
+	assert.Equal(suite.T(), []int{1, 2, 4}, slices.Collect(right.All()))
+	assert.Equal(suite.T(), []int{2}, slices.Collect(dequeued.All()))
+}
+
+func (suite *QueueTestSuite) TestAgainstModel() {
+	rng := rand.New(rand.NewPCG(1, 1))
+
+	// Keep every version along with its model, and pick a random one to extend each step,
+	// so old versions are reused as often as new ones.
+	versions := []persistent.Queue[int]{persistent.NewQueue[int]()}
+	models := [][]int{{}}
+
+	for step := range 3000 {
+		index := rng.IntN(len(versions))
+		version, model := versions[index], models[index]
+
+		if len(model) > 0 && rng.IntN(3) == 0 {
+			item, next, err := version.Dequeue()
+			require.NoError(suite.T(), err)
+			require.Equal(suite.T(), model[0], *item, "step %d", step)
+
+			version, model = next, model[1:]
+		} else {
+			version = version.Enqueue(step)
+			model = append(slices.Clone(model), step)
+		}
+
+		require.Equal(suite.T(), len(model), version.Len())
+		require.NoError(suite.T(), version.CheckInvariants(), "step %d", step)
+		require.Equal(suite.T(), model, append([]int{}, slices.Collect(version.All())...), "step %d", step)
+
+		versions = append(versions, version)
+		models = append(models, model)
+	}
+
+	// The earlier versions must not have changed either.
+	for index, version := range versions {
+		require.Equal(suite.T(), models[index], append([]int{}, slices.Collect(version.All())...))
+	}
+}
+
+func (suite *QueueTestSuite) TestDequeueFromSharedVersion() {
+	const n = 1000
+
+	// Enqueue enough items to go through several rotations, then dequeue from the same
+	// version over and over. Each dequeue evaluates at most one scheduled cell, however
+	// often the version is reused.
+	shared := persistent.NewQueue[int]()
+	for i := range n {
+		shared = shared.Enqueue(i)
+	}
+
+	for range n {
+		item, rest, err := shared.Dequeue()
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), 0, *item)
+		assert.Equal(suite.T(), n-1, rest.Len())
+		require.NoError(suite.T(), rest.CheckInvariants())
+	}
+
+	expected := make([]int, n)
+	for i := range n {
+		expected[i] = i
+	}
+
+	// Goroutines draining the same version share its evaluated cells without locks of
+	// their own.
+	var wg sync.WaitGroup
+
+	drained := make([][]int, 8)
+
+	for worker := range drained {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for version := shared; !version.IsEmpty(); {
+				var item *int
+
+				item, version, _ = version.Dequeue()
+				drained[worker] = append(drained[worker], *item)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	for _, items := range drained {
+		assert.Equal(suite.T(), expected, items)
+	}
+
+	assert.Equal(suite.T(), expected, slices.Collect(shared.All()))
+}
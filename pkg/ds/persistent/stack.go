@@ -0,0 +1,76 @@
+// Package persistent implements immutable data structures that share structure between versions.
+package persistent
+
+import (
+	"iter"
+	"slices"
+)
+
+// Stack represents an immutable LIFO stack. Push and Pop return a new version and leave the
+// old one untouched, sharing every cell the two have in common, so versions are cheap to keep
+// and safe to pass between goroutines without locks. The zero value is an empty stack.
+type Stack[T comparable] struct {
+	top *cell[T]
+}
+
+// NewStack creates a new, empty stack.
+func NewStack[T comparable]() Stack[T] {
+	return Stack[T]{}
+}
+
+// Push returns a new version of the stack with the value on top.
+func (s Stack[T]) Push(value T) Stack[T] {
+	return Stack[T]{top: cons(value, s.top)}
+}
+
+// Pop returns the item on top of the stack and a new version of the stack without it.
+func (s Stack[T]) Pop() (*T, Stack[T], error) {
+	if s.top == nil {
+		return nil, s, ErrEmptyStack
+	}
+
+	item := s.top.value
+
+	return &item, Stack[T]{top: s.top.next}, nil
+}
+
+// Peek returns the item at the top of the stack.
+func (s Stack[T]) Peek() (*T, error) {
+	if s.top == nil {
+		return nil, ErrEmptyStack
+	}
+
+	item := s.top.value
+
+	return &item, nil
+}
+
+// IsEmpty returns true if the stack is empty. Otherwise returns false.
+func (s Stack[T]) IsEmpty() bool {
+	return s.top == nil
+}
+
+// Len returns the length of the stack.
+func (s Stack[T]) Len() int {
+	if s.top == nil {
+		return 0
+	}
+
+	return s.top.len
+}
+
+// All returns an iterator over the items in the stack from the bottom to the top.
+func (s Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		items := make([]T, 0, s.Len())
+		for current := s.top; current != nil; current = current.next {
+			items = append(items, current.value)
+		}
+
+		for _, item := range slices.Backward(items) {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package persistent_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/persistent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StackTestSuite struct {
+	suite.Suite
+}
+
+func TestStackTestSuite(t *testing.T) {
+	suite.Run(t, new(StackTestSuite))
+}
+
+func (suite *StackTestSuite) TestZeroValueIsEmpty() {
+	var empty persistent.Stack[int]
+
+	assert.True(suite.T(), empty.IsEmpty())
+	assert.Equal(suite.T(), 0, empty.Len())
+
+	item, err := empty.Peek()
+	assert.Nil(suite.T(), item)
+	assert.ErrorIs(suite.T(), err, persistent.ErrEmptyStack)
+
+	item, rest, err := empty.Pop()
+	assert.Nil(suite.T(), item)
+	assert.True(suite.T(), rest.IsEmpty())
+	assert.ErrorIs(suite.T(), err, persistent.ErrEmptyStack)
+}
+
+func (suite *StackTestSuite) TestPushAndPop() {
+	stack := persistent.NewStack[int]().Push(1).Push(2).Push(3)
+
+	top, err := stack.Peek()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, *top)
+	assert.Equal(suite.T(), 3, stack.Len())
+
+	top, rest, err := stack.Pop()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, *top)
+	assert.Equal(suite.T(), []int{1, 2}, slices.Collect(rest.All()))
+}
+
+func (suite *StackTestSuite) TestOldVersionsAreUnchanged() {
+	base := persistent.NewStack[int]().Push(1).Push(2)
+	left := base.Push(3)
+	right := base.Push(4)
+	_, popped, err := base.Pop()
+	require.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), []int{1, 2}, slices.Collect(base.All()))
+	assert.Equal(suite.T(), []int{1, 2, 3}, slices.Collect(left.All()))
+	assert.Equal(suite.T(), []int{1, 2, 4}, slices.Collect(right.All()))
+	assert.Equal(suite.T(), []int{1}, slices.Collect(popped.All()))
+}
+
+// TestBacktracking enumerates subsets by keeping each partial choice as its own version,
+// with no undo step.
+func (suite *StackTestSuite) TestBacktracking() {
+	var subsets [][]int
+
+	var choose func(chosen persistent.Stack[int], next int)
+	choose = func(chosen persistent.Stack[int], next int) {
+		if next > 3 {
+			subsets = append(subsets, slices.Collect(chosen.All()))
+
+			return
+		}
+
+		choose(chosen, next+1)
+		choose(chosen.Push(next), next+1)
+	}
+
+	choose(persistent.NewStack[int](), 1)
+
+	assert.Len(suite.T(), subsets, 8)
+	assert.Contains(suite.T(), subsets, []int{1, 3})
+	assert.Contains(suite.T(), subsets, []int{1, 2, 3})
+}
+
+func (suite *StackTestSuite) TestSharedBetweenGoroutines() {
+	base := persistent.NewStack[int]().Push(1)
+
+	var wg sync.WaitGroup
+
+	results := make([]int, 8)
+
+	for g := range results {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			// Every goroutine builds on the same version without any locking.
+			version := base
+			for i := range 100 {
+				version = version.Push(g*100 + i)
+			}
+
+			results[g] = version.Len()
+		}()
+	}
+
+	wg.Wait()
+
+	for _, length := range results {
+		assert.Equal(suite.T(), 101, length)
+	}
+
+	assert.Equal(suite.T(), 1, base.Len())
+}
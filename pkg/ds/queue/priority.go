@@ -1,7 +1,10 @@
 // Package queue implements the queue data structure.
 package queue
 
-import "sync"
+import (
+	"iter"
+	"sync"
+)
 
 // Item is an item that gets added to the queue.
 type Item[T comparable] struct {
@@ -91,3 +94,29 @@ func (pq *PriorityQueue[T]) Len() int {
 
 	return len(pq.items)
 }
+
+// All returns an iterator over copies of the items in the queue in the order they
+// would be dequeued. The items are copied under the lock when iteration starts, so
+// the queue may be modified while iterating.
+func (pq *PriorityQueue[T]) All() iter.Seq[Item[T]] {
+	return func(yield func(Item[T]) bool) {
+		for _, item := range pq.snapshot() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot returns a copy of the items in the queue in the order they would be dequeued.
+func (pq *PriorityQueue[T]) snapshot() []Item[T] {
+	pq.mu.Lock()
+	defer pq.mu.Unlock()
+
+	items := make([]Item[T], len(pq.items))
+	for i, item := range pq.items {
+		items[i] = *item
+	}
+
+	return items
+}
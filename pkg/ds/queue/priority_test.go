@@ -1,6 +1,7 @@
 package queue_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/dqfan2012/playground/pkg/ds/queue"
@@ -160,3 +161,19 @@ func (suite *PriorityQueueTestSuite) TestLenAfterDequeue() {
 	_, _ = suite.queue.Dequeue()
 	assert.Equal(suite.T(), expectedLength, suite.queue.Len())
 }
+
+func (suite *PriorityQueueTestSuite) TestAll() {
+	suite.queue = queue.NewPriorityQueue[int]()
+
+	suite.queue.Enqueue(5, 2)
+	suite.queue.Enqueue(11, 1)
+	suite.queue.Enqueue(8, 3)
+
+	expectedItems := []queue.Item[int]{
+		{Value: 11, Priority: 1},
+		{Value: 5, Priority: 2},
+		{Value: 8, Priority: 3},
+	}
+
+	assert.Equal(suite.T(), expectedItems, slices.Collect(suite.queue.All()))
+}
@@ -2,6 +2,7 @@
 package queue
 
 import (
+	"iter"
 	"sync"
 )
 
@@ -17,6 +18,17 @@ func New[T comparable]() *Queue[T] {
 	return &Queue[T]{}
 }
 
+// Collect creates a new queue by enqueuing the values produced by seq in order.
+func Collect[T comparable](seq iter.Seq[T]) *Queue[T] {
+	newQueue := New[T]()
+
+	for value := range seq {
+		newQueue.items = append(newQueue.items, value)
+	}
+
+	return newQueue
+}
+
 // Enqueue adds an item to the end of the queue.
 func (q *Queue[T]) Enqueue(value T) {
 	q.mu.Lock()
@@ -58,3 +70,27 @@ func (q *Queue[T]) Len() int {
 
 	return len(q.items)
 }
+
+// All returns an iterator over the items in the queue from the front to the back.
+// The items are copied under the lock when iteration starts, so the queue may be
+// modified while iterating.
+func (q *Queue[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range q.snapshot() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot returns a copy of the items in the queue from the front to the back.
+func (q *Queue[T]) snapshot() []T {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	items := make([]T, len(q.items))
+	copy(items, q.items)
+
+	return items
+}
@@ -1,6 +1,7 @@
 package queue_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/dqfan2012/playground/pkg/ds/queue"
@@ -113,3 +114,22 @@ func (suite *QueueTestSuite) TestLenAfterDequeue() {
 	_, _ = suite.queue.Dequeue()
 	assert.Equal(suite.T(), expectedLength, suite.queue.Len())
 }
+
+func (suite *QueueTestSuite) TestAll() {
+	suite.queue = queue.New[int]()
+
+	suite.queue.Enqueue(5)
+	suite.queue.Enqueue(7)
+	suite.queue.Enqueue(9)
+	_, _ = suite.queue.Dequeue()
+
+	assert.Equal(suite.T(), []int{7, 9}, slices.Collect(suite.queue.All()))
+}
+
+func (suite *QueueTestSuite) TestCollect() {
+	suite.queue = queue.Collect(slices.Values([]int{5, 7, 9}))
+	front, _ := suite.queue.Dequeue()
+
+	assert.Equal(suite.T(), 5, *front)
+	assert.Equal(suite.T(), 2, suite.queue.Len())
+}
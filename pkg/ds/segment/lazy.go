@@ -0,0 +1,229 @@
+// Package segment implements the segment tree data structure.
+package segment
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Lazy satisfies the range update interface.
+var _ RangeUpdater[int, int] = (*Lazy[int, int])(nil)
+
+// Ops describes how a Lazy segment tree combines values of type T and applies updates of
+// type U to them.
+type Ops[T, U any] struct {
+	// Combine combines two adjacent aggregates. It must be associative.
+	Combine func(a, b T) T
+	// Identity leaves any aggregate unchanged when combined with it.
+	Identity T
+	// Apply returns the aggregate of length values after the update is applied to each of them.
+	Apply func(update U, aggregate T, length int) T
+	// Compose returns a single update with the effect of applying older and then newer.
+	Compose func(newer, older U) U
+}
+
+// AddSum returns the operations for range sums with updates that add to every value in a range.
+func AddSum[T Number]() Ops[T, T] {
+	return Ops[T, T]{
+		Combine:  func(a, b T) T { return a + b },
+		Apply:    func(add, sum T, length int) T { return sum + add*T(length) },
+		Compose:  func(newer, older T) T { return newer + older },
+		Identity: 0,
+	}
+}
+
+// AddMin returns the operations for range minimums with updates that add to every value in a
+// range. top must be no less than any value, such as math.MaxInt or math.Inf(1).
+func AddMin[T Number](top T) Ops[T, T] {
+	return Ops[T, T]{
+		Combine:  func(a, b T) T { return min(a, b) },
+		Apply:    func(add, minimum T, _ int) T { return minimum + add },
+		Compose:  func(newer, older T) T { return newer + older },
+		Identity: top,
+	}
+}
+
+// AddMax returns the operations for range maximums with updates that add to every value in a
+// range. bottom must be no greater than any value, such as math.MinInt or math.Inf(-1).
+func AddMax[T Number](bottom T) Ops[T, T] {
+	return Ops[T, T]{
+		Combine:  func(a, b T) T { return max(a, b) },
+		Apply:    func(add, maximum T, _ int) T { return maximum + add },
+		Compose:  func(newer, older T) T { return newer + older },
+		Identity: bottom,
+	}
+}
+
+// Lazy represents a segment tree with lazy propagation, which updates every value in a range
+// in O(log n) as well as combining one. An update to a range is applied to the few nodes
+// covering it and left pending there, and is only pushed down to their children when a later
+// operation needs to look inside them.
+// Mutex ensures the implementation of Lazy is thread-safe.
+type Lazy[T, U any] struct {
+	// aggregates[i] combines node i's range with its pending updates applied. Node 1 is the
+	// root covering every value, and node i's children are 2i and 2i+1.
+	aggregates []T
+	// updates[i] is the update waiting to be pushed down to node i's children, if pending[i].
+	updates []U
+	pending []bool
+	n       int
+	ops     Ops[T, U]
+	mu      sync.Mutex
+}
+
+// NewLazy creates a lazy segment tree over the values with the given operations.
+func NewLazy[T, U any](values []T, ops Ops[T, U]) *Lazy[T, U] {
+	l := &Lazy[T, U]{
+		aggregates: make([]T, 4*len(values)),
+		updates:    make([]U, 4*len(values)),
+		pending:    make([]bool, 4*len(values)),
+		n:          len(values),
+		ops:        ops,
+	}
+
+	if l.n > 0 {
+		l.build(1, 0, l.n, values)
+	}
+
+	return l
+}
+
+// Update applies the update to every value in [from, to).
+func (l *Lazy[T, U]) Update(from, to int, update U) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if from < 0 || from > to || to > l.n {
+		return fmt.Errorf("%w: [%d, %d)", ErrInvalidRange, from, to)
+	}
+
+	if from < to {
+		l.update(1, 0, l.n, from, to, update)
+	}
+
+	return nil
+}
+
+// Get returns the value at the index with every update applied.
+func (l *Lazy[T, U]) Get(index int) (T, error) {
+	if index < 0 || index >= l.Len() {
+		var zero T
+
+		return zero, fmt.Errorf("%w: %d", ErrIndexOutOfRange, index)
+	}
+
+	return l.Query(index, index+1)
+}
+
+// Query returns the combination of the values in [from, to) with every update applied, from
+// left to right, or the identity if the range is empty.
+func (l *Lazy[T, U]) Query(from, to int) (T, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if from < 0 || from > to || to > l.n {
+		var zero T
+
+		return zero, fmt.Errorf("%w: [%d, %d)", ErrInvalidRange, from, to)
+	}
+
+	if from == to {
+		return l.ops.Identity, nil
+	}
+
+	return l.query(1, 0, l.n, from, to), nil
+}
+
+// Len returns the number of values.
+func (l *Lazy[T, U]) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.n
+}
+
+// build fills node i, which covers [lo, hi), and the nodes below it from the values.
+func (l *Lazy[T, U]) build(i, lo, hi int, values []T) {
+	if hi-lo == 1 {
+		l.aggregates[i] = values[lo]
+
+		return
+	}
+
+	mid := lo + (hi-lo)/2
+	l.build(2*i, lo, mid, values)
+	l.build(2*i+1, mid, hi, values)
+	l.aggregates[i] = l.ops.Combine(l.aggregates[2*i], l.aggregates[2*i+1])
+}
+
+// update applies the update to the values in [from, to) below node i, which covers [lo, hi).
+func (l *Lazy[T, U]) update(i, lo, hi, from, to int, update U) {
+	if to <= lo || hi <= from {
+		return
+	}
+
+	if from <= lo && hi <= to {
+		l.apply(i, lo, hi, update)
+
+		return
+	}
+
+	mid := l.push(i, lo, hi)
+	l.update(2*i, lo, mid, from, to, update)
+	l.update(2*i+1, mid, hi, from, to, update)
+	l.aggregates[i] = l.ops.Combine(l.aggregates[2*i], l.aggregates[2*i+1])
+}
+
+// query combines the values in [from, to) below node i, which covers [lo, hi) and overlaps
+// the range.
+func (l *Lazy[T, U]) query(i, lo, hi, from, to int) T {
+	if from <= lo && hi <= to {
+		return l.aggregates[i]
+	}
+
+	mid := l.push(i, lo, hi)
+
+	switch {
+	case to <= mid:
+		return l.query(2*i, lo, mid, from, to)
+	case from >= mid:
+		return l.query(2*i+1, mid, hi, from, to)
+	default:
+		return l.ops.Combine(l.query(2*i, lo, mid, from, to), l.query(2*i+1, mid, hi, from, to))
+	}
+}
+
+// apply applies the update to node i, which covers [lo, hi), leaving it pending for the
+// node's children.
+func (l *Lazy[T, U]) apply(i, lo, hi int, update U) {
+	l.aggregates[i] = l.ops.Apply(update, l.aggregates[i], hi-lo)
+
+	if hi-lo == 1 {
+		return
+	}
+
+	if l.pending[i] {
+		update = l.ops.Compose(update, l.updates[i])
+	}
+
+	l.updates[i] = update
+	l.pending[i] = true
+}
+
+// push hands node i's pending update, if any, down to its children and returns the middle
+// of its range [lo, hi), where the children split it.
+func (l *Lazy[T, U]) push(i, lo, hi int) int {
+	mid := lo + (hi-lo)/2
+
+	if l.pending[i] {
+		l.apply(2*i, lo, mid, l.updates[i])
+		l.apply(2*i+1, mid, hi, l.updates[i])
+
+		var zero U
+
+		l.updates[i] = zero
+		l.pending[i] = false
+	}
+
+	return mid
+}
@@ -0,0 +1,190 @@
+package segment_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/segment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type LazyTestSuite struct {
+	suite.Suite
+}
+
+func TestLazyTestSuite(t *testing.T) {
+	suite.Run(t, new(LazyTestSuite))
+}
+
+// affine is the update x -> mul*x + add. Composing affine updates depends on their order,
+// which checks that Compose is called the right way around.
+type affine struct {
+	mul, add int
+}
+
+// affineSum returns the operations for range sums with affine updates.
+func affineSum() segment.Ops[int, affine] {
+	return segment.Ops[int, affine]{
+		Combine: add,
+		Apply: func(update affine, sum int, length int) int {
+			return update.mul*sum + update.add*length
+		},
+		Compose: func(newer, older affine) affine {
+			return affine{mul: newer.mul * older.mul, add: newer.mul*older.add + newer.add}
+		},
+	}
+}
+
+// runLazyModel applies random updates and queries to both a lazy segment tree and a slice.
+// Integer overflow wraps around the same way in both, so results agree even when it happens.
+func runLazyModel[U any](
+	t *testing.T,
+	ops segment.Ops[int, U],
+	randomUpdate func(*rand.Rand) U,
+	applyOne func(U, int) int,
+	seed uint64,
+) {
+	t.Helper()
+
+	rng := rand.New(rand.NewPCG(seed, seed))
+
+	for _, size := range []int{1, 2, 5, 64, 100} {
+		model := randomValues(rng, size)
+		tree := segment.NewLazy(model, ops)
+
+		for step := range 1000 {
+			from, to := randomRange(rng, size)
+
+			switch rng.IntN(3) {
+			case 0:
+				update := randomUpdate(rng)
+				for i := from; i < to; i++ {
+					model[i] = applyOne(update, model[i])
+				}
+
+				require.NoError(t, tree.Update(from, to, update))
+			case 1:
+				index := rng.IntN(size)
+
+				value, err := tree.Get(index)
+				require.NoError(t, err)
+				require.Equal(t, model[index], value, "size %d step %d", size, step)
+			default:
+				result, err := tree.Query(from, to)
+				require.NoError(t, err)
+				require.Equal(t, fold(model, from, to, ops.Combine, ops.Identity), result,
+					"size %d step %d", size, step)
+			}
+		}
+	}
+}
+
+func (suite *LazyTestSuite) TestAddSumAgainstBruteForce() {
+	runLazyModel(suite.T(), segment.AddSum[int](), func(rng *rand.Rand) int {
+		return rng.IntN(21) - 10
+	}, add, 16)
+}
+
+func (suite *LazyTestSuite) TestAddMinAgainstBruteForce() {
+	runLazyModel(suite.T(), segment.AddMin(math.MaxInt), func(rng *rand.Rand) int {
+		return rng.IntN(21) - 10
+	}, add, 17)
+}
+
+func (suite *LazyTestSuite) TestAddMaxAgainstBruteForce() {
+	runLazyModel(suite.T(), segment.AddMax(math.MinInt), func(rng *rand.Rand) int {
+		return rng.IntN(21) - 10
+	}, add, 18)
+}
+
+func (suite *LazyTestSuite) TestAffineSumAgainstBruteForce() {
+	runLazyModel(suite.T(), affineSum(), func(rng *rand.Rand) affine {
+		return affine{mul: rng.IntN(5) - 2, add: rng.IntN(21) - 10}
+	}, func(update affine, value int) int {
+		return update.mul*value + update.add
+	}, 19)
+}
+
+func (suite *LazyTestSuite) TestFloatMetrics() {
+	// Hourly latencies, with a clock skew correction applied to a window of them.
+	latencies := segment.NewLazy([]float64{12.5, 9.0, 30.25, 7.5}, segment.AddMin(math.Inf(1)))
+
+	require.NoError(suite.T(), latencies.Update(1, 3, -2))
+
+	fastest, err := latencies.Query(0, 4)
+	require.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 7.0, fastest, 1e-9)
+
+	fastest, err = latencies.Query(2, 3)
+	require.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 28.25, fastest, 1e-9)
+}
+
+func (suite *LazyTestSuite) TestInvalidRanges() {
+	tree := segment.NewLazy([]int{1, 2, 3}, segment.AddSum[int]())
+
+	for _, bounds := range [][2]int{{-1, 2}, {2, 1}, {0, 4}} {
+		assert.ErrorIs(suite.T(), tree.Update(bounds[0], bounds[1], 1), segment.ErrInvalidRange)
+
+		_, err := tree.Query(bounds[0], bounds[1])
+		assert.ErrorIs(suite.T(), err, segment.ErrInvalidRange)
+	}
+
+	_, err := tree.Get(3)
+	require.ErrorIs(suite.T(), err, segment.ErrIndexOutOfRange)
+
+	// An empty range updates nothing and queries the identity.
+	require.NoError(suite.T(), tree.Update(1, 1, 100))
+
+	total, err := tree.Query(1, 1)
+	require.NoError(suite.T(), err)
+	assert.Zero(suite.T(), total)
+
+	total, _ = tree.Query(0, 3)
+	assert.Equal(suite.T(), 6, total)
+}
+
+func (suite *LazyTestSuite) TestEmpty() {
+	tree := segment.NewLazy([]int{}, segment.AddSum[int]())
+
+	require.NoError(suite.T(), tree.Update(0, 0, 1))
+
+	total, err := tree.Query(0, 0)
+	require.NoError(suite.T(), err)
+	assert.Zero(suite.T(), total)
+	assert.Equal(suite.T(), 0, tree.Len())
+}
+
+func (suite *LazyTestSuite) TestConcurrentUpdates() {
+	tree := segment.NewLazy(make([]int, 64), segment.AddSum[int]())
+
+	var wg sync.WaitGroup
+
+	for worker := range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range 100 {
+				_ = tree.Update(worker, 64-worker, 1)
+				_, _ = tree.Query(0, 64)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	total, _ := tree.Query(0, 64)
+
+	expected := 0
+	for worker := range 8 {
+		expected += 100 * (64 - 2*worker)
+	}
+
+	assert.Equal(suite.T(), expected, total)
+}
@@ -0,0 +1,111 @@
+// Package segment implements the segment tree data structure.
+package segment
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Tree satisfies the range query interface.
+var _ Querier[int] = (*Tree[int])(nil)
+
+// Tree represents a segment tree: a binary tree over a slice whose nodes hold the combination
+// of the values below them, so both changing a value and combining a range take O(log n).
+// The combine function must be associative and identity must leave any value unchanged when
+// combined with it, such as + and 0 for sums or min and the largest value for minimums.
+// combine doesn't need to be commutative: ranges are always combined from left to right.
+// Mutex ensures the implementation of Tree is thread-safe.
+type Tree[T any] struct {
+	// nodes holds the leaves in nodes[n:] and each internal node i in nodes[i], combining
+	// nodes[2i] and nodes[2i+1]. nodes[0] is unused.
+	nodes    []T
+	n        int
+	combine  func(a, b T) T
+	identity T
+	mu       sync.Mutex
+}
+
+// New creates a segment tree over the values, combining them with combine.
+func New[T any](values []T, combine func(a, b T) T, identity T) *Tree[T] {
+	n := len(values)
+	nodes := make([]T, 2*n)
+	copy(nodes[n:], values)
+
+	for i := n - 1; i > 0; i-- {
+		nodes[i] = combine(nodes[2*i], nodes[2*i+1])
+	}
+
+	return &Tree[T]{nodes: nodes, n: n, combine: combine, identity: identity}
+}
+
+// Set sets the value at the index.
+func (t *Tree[T]) Set(index int, value T) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if index < 0 || index >= t.n {
+		return fmt.Errorf("%w: %d", ErrIndexOutOfRange, index)
+	}
+
+	i := index + t.n
+	t.nodes[i] = value
+
+	for i /= 2; i > 0; i /= 2 {
+		t.nodes[i] = t.combine(t.nodes[2*i], t.nodes[2*i+1])
+	}
+
+	return nil
+}
+
+// Get returns the value at the index.
+func (t *Tree[T]) Get(index int) (T, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if index < 0 || index >= t.n {
+		var zero T
+
+		return zero, fmt.Errorf("%w: %d", ErrIndexOutOfRange, index)
+	}
+
+	return t.nodes[index+t.n], nil
+}
+
+// Query returns the combination of the values in [from, to), from left to right, or the
+// identity if the range is empty.
+func (t *Tree[T]) Query(from, to int) (T, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if from < 0 || from > to || to > t.n {
+		var zero T
+
+		return zero, fmt.Errorf("%w: [%d, %d)", ErrInvalidRange, from, to)
+	}
+
+	// Climb from both ends, gathering the nodes that stick out of the range's edges. The left
+	// ones go on the right of left and the right ones on the left of right, keeping the order.
+	left, right := t.identity, t.identity
+
+	for lo, hi := from+t.n, to+t.n; lo < hi; lo, hi = lo/2, hi/2 {
+		if lo%2 == 1 {
+			left = t.combine(left, t.nodes[lo])
+			lo++
+		}
+
+		if hi%2 == 1 {
+			hi--
+			right = t.combine(t.nodes[hi], right)
+		}
+	}
+
+	return t.combine(left, right), nil
+}
+
+// Len returns the number of values.
+func (t *Tree[T]) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.n
+}
@@ -0,0 +1,34 @@
+// Package segment implements the segment tree data structure.
+package segment
+
+import "errors"
+
+var (
+	// ErrIndexOutOfRange is an error indicating an index outside the tree.
+	ErrIndexOutOfRange = errors.New("index out of range")
+
+	// ErrInvalidRange is an error indicating a range whose start is after its end or that
+	// reaches outside the tree.
+	ErrInvalidRange = errors.New("invalid range")
+)
+
+// Number is a type the ready-made operations can add, multiply and compare.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Querier defines the operations for a structure answering range queries.
+type Querier[T any] interface {
+	Get(index int) (T, error)
+	Query(from, to int) (T, error)
+	Len() int
+}
+
+// RangeUpdater defines the operations for a structure answering range queries that also
+// updates whole ranges at once.
+type RangeUpdater[T, U any] interface {
+	Querier[T]
+	Update(from, to int, update U) error
+}
@@ -0,0 +1,183 @@
+package segment_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/segment"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type TreeTestSuite struct {
+	suite.Suite
+	sums *segment.Tree[int]
+}
+
+func TestTreeTestSuite(t *testing.T) {
+	suite.Run(t, new(TreeTestSuite))
+}
+
+func (suite *TreeTestSuite) SetupTest() {
+	suite.sums = segment.New([]int{3, 1, 4, 1, 5, 9, 2, 6}, add, 0)
+}
+
+// add returns a + b.
+func add(a, b int) int {
+	return a + b
+}
+
+// randomValues returns n random values in [-100, 100).
+func randomValues(rng *rand.Rand, n int) []int {
+	values := make([]int, n)
+	for i := range values {
+		values[i] = rng.IntN(200) - 100
+	}
+
+	return values
+}
+
+// randomRange returns a random range [from, to) within n values, which may be empty.
+func randomRange(rng *rand.Rand, n int) (int, int) {
+	from := rng.IntN(n + 1)
+
+	return from, from + rng.IntN(n-from+1)
+}
+
+// fold combines the values in [from, to) from left to right, starting with the identity.
+func fold[T any](values []T, from, to int, combine func(a, b T) T, identity T) T {
+	result := identity
+	for _, value := range values[from:to] {
+		result = combine(result, value)
+	}
+
+	return result
+}
+
+func (suite *TreeTestSuite) TestQuery() {
+	total, err := suite.sums.Query(2, 6)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 19, total)
+
+	total, err = suite.sums.Query(0, 8)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 31, total)
+
+	total, err = suite.sums.Query(4, 4)
+	require.NoError(suite.T(), err)
+	assert.Zero(suite.T(), total)
+}
+
+func (suite *TreeTestSuite) TestSetAndGet() {
+	require.NoError(suite.T(), suite.sums.Set(5, 0))
+
+	value, err := suite.sums.Get(5)
+	require.NoError(suite.T(), err)
+	assert.Zero(suite.T(), value)
+
+	total, _ := suite.sums.Query(0, 8)
+	assert.Equal(suite.T(), 22, total)
+	assert.Equal(suite.T(), 8, suite.sums.Len())
+}
+
+func (suite *TreeTestSuite) TestInvalidIndexes() {
+	for _, index := range []int{-1, 8} {
+		assert.ErrorIs(suite.T(), suite.sums.Set(index, 1), segment.ErrIndexOutOfRange)
+
+		_, err := suite.sums.Get(index)
+		assert.ErrorIs(suite.T(), err, segment.ErrIndexOutOfRange)
+	}
+
+	for _, bounds := range [][2]int{{-1, 2}, {3, 2}, {0, 9}} {
+		_, err := suite.sums.Query(bounds[0], bounds[1])
+		assert.ErrorIs(suite.T(), err, segment.ErrInvalidRange, "range %v", bounds)
+	}
+}
+
+func (suite *TreeTestSuite) TestEmpty() {
+	empty := segment.New([]int{}, add, 0)
+
+	total, err := empty.Query(0, 0)
+	require.NoError(suite.T(), err)
+	assert.Zero(suite.T(), total)
+	assert.Equal(suite.T(), 0, empty.Len())
+}
+
+func (suite *TreeTestSuite) TestNonCommutativeCombine() {
+	// Concatenation is associative but not commutative, so it checks the order ranges are
+	// combined in.
+	letters := []string{"a", "b", "c", "d", "e", "f", "g"}
+	concat := func(a, b string) string { return a + b }
+	tree := segment.New(letters, concat, "")
+
+	for from := range len(letters) + 1 {
+		for to := from; to <= len(letters); to++ {
+			text, err := tree.Query(from, to)
+			require.NoError(suite.T(), err)
+			assert.Equal(suite.T(), fold(letters, from, to, concat, ""), text, "[%d, %d)", from, to)
+		}
+	}
+}
+
+func (suite *TreeTestSuite) TestAgainstBruteForce() {
+	rng := rand.New(rand.NewPCG(14, 15))
+
+	combines := map[string]struct {
+		combine  func(a, b int) int
+		identity int
+	}{
+		"sum": {combine: add, identity: 0},
+		"min": {combine: func(a, b int) int { return min(a, b) }, identity: math.MaxInt},
+		"max": {combine: func(a, b int) int { return max(a, b) }, identity: math.MinInt},
+	}
+
+	for name, c := range combines {
+		for _, size := range []int{1, 2, 5, 64, 100} {
+			model := randomValues(rng, size)
+			tree := segment.New(model, c.combine, c.identity)
+
+			for step := range 1000 {
+				if rng.IntN(2) == 0 {
+					index, value := rng.IntN(size), rng.IntN(200)-100
+					model[index] = value
+
+					require.NoError(suite.T(), tree.Set(index, value))
+
+					continue
+				}
+
+				from, to := randomRange(rng, size)
+
+				result, err := tree.Query(from, to)
+				require.NoError(suite.T(), err)
+				require.Equal(suite.T(), fold(model, from, to, c.combine, c.identity), result,
+					"%s size %d step %d", name, size, step)
+			}
+		}
+	}
+}
+
+func (suite *TreeTestSuite) TestConcurrentSets() {
+	var wg sync.WaitGroup
+
+	for worker := range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range 100 {
+				_ = suite.sums.Set(worker, worker)
+				_, _ = suite.sums.Query(0, 8)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	total, _ := suite.sums.Query(0, 8)
+	assert.Equal(suite.T(), 28, total)
+}
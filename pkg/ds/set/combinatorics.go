@@ -0,0 +1,162 @@
+// Package set implements the set data structure.
+package set
+
+import "iter"
+
+// Pair holds one element from each set of a Cartesian product.
+type Pair[A, B comparable] struct {
+	First  A
+	Second B
+}
+
+// PowerSet returns an iterator over every subset of the set, including the empty set and
+// the set itself. A set of n items has 2^n subsets, so they are produced one at a time
+// rather than all at once. The items are captured when iteration starts.
+func PowerSet[T comparable](s *Set[T]) iter.Seq[*Set[T]] {
+	return func(yield func(*Set[T]) bool) {
+		items := s.snapshot()
+		// chosen is a binary counter: chosen[i] marks whether items[i] is in the subset.
+		chosen := make([]bool, len(items))
+
+		for {
+			if !yield(subsetOf(items, chosen)) {
+				return
+			}
+
+			// Increment the counter, stopping once it wraps back around to zero.
+			i := 0
+			for i < len(chosen) && chosen[i] {
+				chosen[i] = false
+				i++
+			}
+
+			if i == len(chosen) {
+				return
+			}
+
+			chosen[i] = true
+		}
+	}
+}
+
+// Product returns an iterator over the Cartesian product of two sets: every pair made of
+// one element from setA and one element from setB. The items are captured when iteration starts.
+func Product[A, B comparable](setA *Set[A], setB *Set[B]) iter.Seq[Pair[A, B]] {
+	return func(yield func(Pair[A, B]) bool) {
+		itemsA := setA.snapshot()
+		itemsB := setB.snapshot()
+
+		for _, a := range itemsA {
+			for _, b := range itemsB {
+				if !yield(Pair[A, B]{First: a, Second: b}) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Combinations returns an iterator over every subset of the set with exactly k items.
+// Nothing is produced if k is negative or larger than the set. The items are captured
+// when iteration starts.
+func Combinations[T comparable](s *Set[T], k int) iter.Seq[*Set[T]] {
+	return func(yield func(*Set[T]) bool) {
+		items := s.snapshot()
+		if k < 0 || k > len(items) {
+			return
+		}
+
+		// indices holds the positions of the chosen items in increasing order.
+		indices := make([]int, k)
+		for i := range indices {
+			indices[i] = i
+		}
+
+		for {
+			combination := New[T]()
+			for _, index := range indices {
+				combination.items[items[index]] = struct{}{}
+			}
+
+			if !yield(combination) {
+				return
+			}
+
+			// Find the rightmost index that can still move forward.
+			i := k - 1
+			for i >= 0 && indices[i] == len(items)-k+i {
+				i--
+			}
+
+			if i < 0 {
+				return
+			}
+
+			// Move it forward and reset every index after it.
+			indices[i]++
+			for j := i + 1; j < k; j++ {
+				indices[j] = indices[j-1] + 1
+			}
+		}
+	}
+}
+
+// Partitions returns an iterator over every way of splitting the set into non-empty,
+// disjoint blocks whose union is the set. The number of partitions grows faster than
+// exponentially (the Bell numbers), so they are produced one at a time. The items are
+// captured when iteration starts.
+func Partitions[T comparable](s *Set[T]) iter.Seq[[]*Set[T]] {
+	return func(yield func([]*Set[T]) bool) {
+		items := s.snapshot()
+		// blockOf assigns every item to a block. It is built as a restricted growth string,
+		// where every item joins an existing block or opens the next new one, so every
+		// partition is produced exactly once.
+		blockOf := make([]int, len(items))
+
+		var assign func(position, blocks int) bool
+		assign = func(position, blocks int) bool {
+			if position == len(items) {
+				return yield(partitionOf(items, blockOf, blocks))
+			}
+
+			for block := 0; block <= blocks; block++ {
+				blockOf[position] = block
+
+				if !assign(position+1, max(blocks, block+1)) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		assign(0, 0)
+	}
+}
+
+// subsetOf creates a new set holding the chosen items.
+func subsetOf[T comparable](items []T, chosen []bool) *Set[T] {
+	subset := New[T]()
+
+	for i, isChosen := range chosen {
+		if isChosen {
+			subset.items[items[i]] = struct{}{}
+		}
+	}
+
+	return subset
+}
+
+// partitionOf creates the blocks of a partition from the block assigned to every item.
+func partitionOf[T comparable](items []T, blockOf []int, blocks int) []*Set[T] {
+	partition := make([]*Set[T], blocks)
+	for i := range partition {
+		partition[i] = New[T]()
+	}
+
+	for i, block := range blockOf {
+		partition[block].items[items[i]] = struct{}{}
+	}
+
+	return partition
+}
@@ -0,0 +1,182 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/set"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CombinatoricsTestSuite struct {
+	suite.Suite
+	set *set.Set[int]
+}
+
+func TestCombinatoricsTestSuite(t *testing.T) {
+	suite.Run(t, new(CombinatoricsTestSuite))
+}
+
+func (suite *CombinatoricsTestSuite) SetupTest() {
+	suite.set = set.New[int]()
+
+	suite.set.Add(1)
+	suite.set.Add(2)
+	suite.set.Add(3)
+	suite.set.Add(4)
+}
+
+func (suite *CombinatoricsTestSuite) TestPowerSet() {
+	expectedCount := 16
+	sizes := make(map[int]int)
+	count := 0
+
+	for subset := range set.PowerSet(suite.set) {
+		assert.True(suite.T(), suite.set.IsSubset(subset))
+
+		sizes[subset.Len()]++
+		count++
+	}
+
+	assert.Equal(suite.T(), expectedCount, count)
+	// The number of subsets of each size follows the binomial coefficients.
+	assert.Equal(suite.T(), map[int]int{0: 1, 1: 4, 2: 6, 3: 4, 4: 1}, sizes)
+}
+
+func (suite *CombinatoricsTestSuite) TestPowerSetEmpty() {
+	count := 0
+
+	for subset := range set.PowerSet(set.New[int]()) {
+		assert.True(suite.T(), subset.IsEmpty())
+
+		count++
+	}
+
+	assert.Equal(suite.T(), 1, count)
+}
+
+func (suite *CombinatoricsTestSuite) TestPowerSetIsLazy() {
+	large := set.New[int]()
+	for i := range 100 {
+		large.Add(i)
+	}
+
+	// 2^100 subsets can never be materialized, but taking a few must still work.
+	count := 0
+
+	for range set.PowerSet(large) {
+		count++
+		if count == 5 {
+			break
+		}
+	}
+
+	assert.Equal(suite.T(), 5, count)
+}
+
+func (suite *CombinatoricsTestSuite) TestProduct() {
+	letters := set.New[string]()
+	letters.Add("a")
+	letters.Add("b")
+
+	pairs := make(map[set.Pair[int, string]]bool)
+	for pair := range set.Product(suite.set, letters) {
+		pairs[pair] = true
+	}
+
+	assert.Len(suite.T(), pairs, 8)
+	assert.True(suite.T(), pairs[set.Pair[int, string]{First: 3, Second: "b"}])
+}
+
+func (suite *CombinatoricsTestSuite) TestProductWithEmptySet() {
+	count := 0
+	for range set.Product(suite.set, set.New[int]()) {
+		count++
+	}
+
+	assert.Equal(suite.T(), 0, count)
+}
+
+func (suite *CombinatoricsTestSuite) TestCombinations() {
+	expected := map[int]int{-1: 0, 0: 1, 1: 4, 2: 6, 3: 4, 4: 1, 5: 0}
+
+	for k, expectedCount := range expected {
+		count := 0
+
+		for combination := range set.Combinations(suite.set, k) {
+			assert.Equal(suite.T(), k, combination.Len())
+			assert.True(suite.T(), suite.set.IsSubset(combination))
+
+			count++
+		}
+
+		assert.Equal(suite.T(), expectedCount, count, "k=%d", k)
+	}
+}
+
+func (suite *CombinatoricsTestSuite) TestCombinationsAreDistinct() {
+	seen := make(map[int]bool)
+
+	for combination := range set.Combinations(suite.set, 2) {
+		// Encode the members as a bitmask so combinations can be compared.
+		mask := 0
+
+		for value := 1; value <= 4; value++ {
+			if combination.Contains(value) {
+				mask |= 1 << value
+			}
+		}
+
+		assert.False(suite.T(), seen[mask])
+		seen[mask] = true
+	}
+
+	assert.Len(suite.T(), seen, 6)
+}
+
+func (suite *CombinatoricsTestSuite) TestPartitions() {
+	// The number of partitions of a set of size n is the n-th Bell number.
+	bellNumbers := []int{1, 1, 2, 5, 15, 52}
+
+	for n, expectedCount := range bellNumbers {
+		source := set.New[int]()
+		for i := range n {
+			source.Add(i)
+		}
+
+		count := 0
+
+		for partition := range set.Partitions(source) {
+			union := set.New[int]()
+			total := 0
+
+			for _, block := range partition {
+				assert.False(suite.T(), block.IsEmpty())
+
+				union = union.Union(block)
+				total += block.Len()
+			}
+
+			// The blocks are disjoint and cover the whole set.
+			assert.Equal(suite.T(), n, total)
+			assert.Equal(suite.T(), n, union.Len())
+
+			count++
+		}
+
+		assert.Equal(suite.T(), expectedCount, count, "n=%d", n)
+	}
+}
+
+func (suite *CombinatoricsTestSuite) TestPartitionsStopEarly() {
+	count := 0
+
+	for range set.Partitions(suite.set) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+
+	assert.Equal(suite.T(), 3, count)
+}
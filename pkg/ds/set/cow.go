@@ -0,0 +1,170 @@
+// Package set implements the set data structure.
+package set
+
+import (
+	"iter"
+	"sync"
+	"sync/atomic"
+)
+
+// CopyOnWriteSet represents a set optimized for read-mostly workloads.
+// Reads load the current items through an atomic pointer and never lock, so they run in
+// parallel. Writes are serialized by a mutex and copy the items before publishing them,
+// which makes every write O(n). Use Set when writes are frequent.
+type CopyOnWriteSet[T comparable] struct {
+	// items points at the current map. A published map is never modified again.
+	items atomic.Pointer[map[T]struct{}]
+	mu    sync.Mutex
+}
+
+// Snapshot represents an immutable view of a CopyOnWriteSet at a point in time.
+// It can be iterated and queried without any locking.
+type Snapshot[T comparable] struct {
+	items map[T]struct{}
+}
+
+// NewCopyOnWriteSet creates a new copy-on-write set.
+func NewCopyOnWriteSet[T comparable]() *CopyOnWriteSet[T] {
+	return &CopyOnWriteSet[T]{}
+}
+
+// load returns the current map. It is nil until the first write.
+func (s *CopyOnWriteSet[T]) load() map[T]struct{} {
+	if items := s.items.Load(); items != nil {
+		return *items
+	}
+
+	return nil
+}
+
+// update copies the current map, applies change to the copy and publishes it.
+// The caller must hold the lock.
+func (s *CopyOnWriteSet[T]) update(change func(map[T]struct{})) {
+	current := s.load()
+	items := make(map[T]struct{}, len(current)+1)
+
+	for k := range current {
+		items[k] = struct{}{}
+	}
+
+	change(items)
+	s.items.Store(&items)
+}
+
+// Add adds a value to the set if the value isn't present.
+func (s *CopyOnWriteSet[T]) Add(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.load()[value]; exists {
+		return
+	}
+
+	s.update(func(items map[T]struct{}) {
+		items[value] = struct{}{}
+	})
+}
+
+// AddAll adds every value to the set with a single copy.
+func (s *CopyOnWriteSet[T]) AddAll(values ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.update(func(items map[T]struct{}) {
+		for _, value := range values {
+			items[value] = struct{}{}
+		}
+	})
+}
+
+// Remove removes a value from the set if it is present.
+func (s *CopyOnWriteSet[T]) Remove(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.load()[value]; !exists {
+		return
+	}
+
+	s.update(func(items map[T]struct{}) {
+		delete(items, value)
+	})
+}
+
+// Contains checks if the set contains the given value, returning true if it does and false otherwise.
+func (s *CopyOnWriteSet[T]) Contains(value T) bool {
+	_, exists := s.load()[value]
+
+	return exists
+}
+
+// Clear clears all the items from the set.
+func (s *CopyOnWriteSet[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items.Store(nil)
+}
+
+// IsEmpty returns true if the set is empty. Otherwise, false.
+func (s *CopyOnWriteSet[T]) IsEmpty() bool {
+	return len(s.load()) == 0
+}
+
+// Len returns number of items in the set.
+func (s *CopyOnWriteSet[T]) Len() int {
+	return len(s.load())
+}
+
+// All returns an iterator over the items in the set, in no particular order.
+// It iterates over the items present when iteration starts, without copying or locking.
+func (s *CopyOnWriteSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.Snapshot().All()(yield)
+	}
+}
+
+// Snapshot returns an immutable view of the set's current items. It is O(1), since the
+// current items are never modified.
+func (s *CopyOnWriteSet[T]) Snapshot() Snapshot[T] {
+	return Snapshot[T]{items: s.load()}
+}
+
+// Contains checks if the snapshot contains the given value.
+func (s Snapshot[T]) Contains(value T) bool {
+	_, exists := s.items[value]
+
+	return exists
+}
+
+// IsEmpty returns true if the snapshot is empty. Otherwise, false.
+func (s Snapshot[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Len returns number of items in the snapshot.
+func (s Snapshot[T]) Len() int {
+	return len(s.items)
+}
+
+// All returns an iterator over the items in the snapshot, in no particular order.
+func (s Snapshot[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for k := range s.items {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Set returns a new, mutable Set holding the snapshot's items.
+func (s Snapshot[T]) Set() *Set[T] {
+	newSet := New[T]()
+
+	for k := range s.items {
+		newSet.items[k] = struct{}{}
+	}
+
+	return newSet
+}
@@ -0,0 +1,174 @@
+package set_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/set"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type CopyOnWriteSetTestSuite struct {
+	suite.Suite
+	set *set.CopyOnWriteSet[int]
+}
+
+func TestCopyOnWriteSetTestSuite(t *testing.T) {
+	suite.Run(t, new(CopyOnWriteSetTestSuite))
+}
+
+func (suite *CopyOnWriteSetTestSuite) SetupTest() {
+	suite.set = set.NewCopyOnWriteSet[int]()
+}
+
+func (suite *CopyOnWriteSetTestSuite) TestEmpty() {
+	assert.True(suite.T(), suite.set.IsEmpty())
+	assert.Equal(suite.T(), 0, suite.set.Len())
+	assert.False(suite.T(), suite.set.Contains(1))
+
+	var zero set.CopyOnWriteSet[int]
+
+	assert.True(suite.T(), zero.IsEmpty())
+}
+
+func (suite *CopyOnWriteSetTestSuite) TestAddRemoveContains() {
+	suite.set.Add(3)
+	suite.set.Add(5)
+	suite.set.Add(5)
+	suite.set.AddAll(7, 9)
+	suite.set.Remove(3)
+	suite.set.Remove(42)
+
+	assert.Equal(suite.T(), 3, suite.set.Len())
+	assert.True(suite.T(), suite.set.Contains(5))
+	assert.True(suite.T(), suite.set.Contains(9))
+	assert.False(suite.T(), suite.set.Contains(3))
+	assert.False(suite.T(), suite.set.IsEmpty())
+}
+
+func (suite *CopyOnWriteSetTestSuite) TestClear() {
+	suite.set.AddAll(1, 2, 3)
+	suite.set.Clear()
+
+	assert.True(suite.T(), suite.set.IsEmpty())
+	assert.False(suite.T(), suite.set.Contains(1))
+}
+
+func (suite *CopyOnWriteSetTestSuite) TestAll() {
+	suite.set.AddAll(3, 1, 2)
+
+	assert.Equal(suite.T(), []int{1, 2, 3}, slices.Sorted(suite.set.All()))
+}
+
+func (suite *CopyOnWriteSetTestSuite) TestSnapshotIsImmutable() {
+	suite.set.AddAll(1, 2, 3)
+
+	snapshot := suite.set.Snapshot()
+
+	suite.set.Add(4)
+	suite.set.Remove(1)
+	suite.set.Clear()
+
+	assert.Equal(suite.T(), 3, snapshot.Len())
+	assert.True(suite.T(), snapshot.Contains(1))
+	assert.False(suite.T(), snapshot.Contains(4))
+	assert.False(suite.T(), snapshot.IsEmpty())
+	assert.Equal(suite.T(), []int{1, 2, 3}, slices.Sorted(snapshot.All()))
+}
+
+func (suite *CopyOnWriteSetTestSuite) TestSnapshotSet() {
+	suite.set.AddAll(1, 2)
+
+	mutable := suite.set.Snapshot().Set()
+	mutable.Add(3)
+
+	assert.Equal(suite.T(), 3, mutable.Len())
+	assert.False(suite.T(), suite.set.Contains(3))
+}
+
+func (suite *CopyOnWriteSetTestSuite) TestModifyWhileIterating() {
+	suite.set.AddAll(1, 2)
+
+	count := 0
+
+	for item := range suite.set.All() {
+		suite.set.Add(item * 10)
+
+		count++
+	}
+
+	assert.Equal(suite.T(), 2, count)
+	assert.Equal(suite.T(), 4, suite.set.Len())
+}
+
+func (suite *CopyOnWriteSetTestSuite) TestConcurrentReadersAndWriters() {
+	readers := 8
+	writes := 200
+
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		for i := range writes {
+			suite.set.Add(i)
+		}
+	}()
+
+	for range readers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range writes {
+				snapshot := suite.set.Snapshot()
+				// Values are added in order, so a snapshot holding i holds everything before it.
+				if snapshot.Contains(i) {
+					assert.True(suite.T(), snapshot.Contains(0))
+				}
+
+				_ = suite.set.Contains(i)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(suite.T(), writes, suite.set.Len())
+}
+
+func BenchmarkContainsParallel(b *testing.B) {
+	size := 1000
+	mutexSet := set.New[int]()
+	cowSet := set.NewCopyOnWriteSet[int]()
+
+	for i := range size {
+		mutexSet.Add(i)
+		cowSet.Add(i)
+	}
+
+	b.Run("Set", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				mutexSet.Contains(i % size)
+				i++
+			}
+		})
+	})
+
+	b.Run("CopyOnWriteSet", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				cowSet.Contains(i % size)
+				i++
+			}
+		})
+	})
+}
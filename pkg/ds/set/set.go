@@ -2,6 +2,7 @@
 package set
 
 import (
+	"iter"
 	"sync"
 )
 
@@ -21,6 +22,17 @@ func New[T comparable]() *Set[T] {
 	}
 }
 
+// Collect creates a new set holding the values produced by seq.
+func Collect[T comparable](seq iter.Seq[T]) *Set[T] {
+	newSet := New[T]()
+
+	for value := range seq {
+		newSet.items[value] = struct{}{}
+	}
+
+	return newSet
+}
+
 // Add adds a value to the set if the value isn't present.
 func (s *Set[T]) Add(value T) {
 	s.mu.Lock()
@@ -164,3 +176,29 @@ func (s *Set[T]) Len() int {
 
 	return len(s.items)
 }
+
+// All returns an iterator over the items in the set, in no particular order.
+// The items are copied under the lock when iteration starts, so the set may be
+// modified while iterating.
+func (s *Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.snapshot() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot returns a copy of the items in the set, in no particular order.
+func (s *Set[T]) snapshot() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := make([]T, 0, len(s.items))
+	for k := range s.items {
+		items = append(items, k)
+	}
+
+	return items
+}
@@ -1,6 +1,7 @@
 package set_test
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/dqfan2012/playground/pkg/ds/set"
@@ -282,3 +283,41 @@ func (suite *SetTestSuite) TestEmptySetLen() {
 
 	assert.Equal(suite.T(), expectedLength, suite.set.Len())
 }
+
+func (suite *SetTestSuite) TestAll() {
+	suite.set = set.New[int]()
+
+	suite.set.Add(3)
+	suite.set.Add(5)
+	suite.set.Add(9)
+
+	items := slices.Sorted(suite.set.All())
+
+	assert.Equal(suite.T(), []int{3, 5, 9}, items)
+}
+
+func (suite *SetTestSuite) TestAllSnapshot() {
+	suite.set = set.New[int]()
+
+	suite.set.Add(1)
+	suite.set.Add(2)
+
+	// Modifying the set while iterating doesn't deadlock or change what is iterated.
+	count := 0
+
+	for item := range suite.set.All() {
+		suite.set.Add(item * 10)
+
+		count++
+	}
+
+	assert.Equal(suite.T(), 2, count)
+	assert.Equal(suite.T(), 4, suite.set.Len())
+}
+
+func (suite *SetTestSuite) TestCollect() {
+	suite.set = set.Collect(slices.Values([]int{1, 2, 2, 3}))
+
+	assert.Equal(suite.T(), 3, suite.set.Len())
+	assert.True(suite.T(), suite.set.Contains(2))
+}
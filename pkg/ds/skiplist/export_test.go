@@ -0,0 +1,90 @@
+package skiplist
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+)
+
+// errInvariant is an error indicating a skip list's internal bookkeeping is inconsistent.
+var errInvariant = errors.New("invariant violated")
+
+// CheckInvariants exposes checkInvariants to the skiplist_test package.
+func (s *SkipList[K, V]) CheckInvariants() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.checkInvariants()
+}
+
+// checkInvariants verifies the bottom level is sorted and holds len nodes, every level is a
+// sublist of the one below, spans match the distances on the bottom level and no level above
+// the ones in use is linked.
+func (s *SkipList[K, V]) checkInvariants() error {
+	positions := map[*node[K, V]]int{s.head: 0}
+
+	for prev, current := s.head, s.head.next[0]; current != nil; prev, current = current, current.next[0] {
+		position := len(positions)
+		if position > s.len {
+			return fmt.Errorf("%w: more than len %d nodes on the bottom level", errInvariant, s.len)
+		}
+
+		if prev != s.head && cmp.Compare(current.key, prev.key) <= 0 {
+			return fmt.Errorf("%w: key %v at position %d isn't sorted", errInvariant, current.key, position)
+		}
+
+		positions[current] = position
+	}
+
+	if len(positions)-1 != s.len {
+		return fmt.Errorf("%w: %d nodes on the bottom level, len is %d", errInvariant, len(positions)-1, s.len)
+	}
+
+	if s.level < 1 || s.level > maxLevel {
+		return fmt.Errorf("%w: level %d", errInvariant, s.level)
+	}
+
+	if s.level > 1 && s.head.next[s.level-1] == nil {
+		return fmt.Errorf("%w: top level %d is empty", errInvariant, s.level)
+	}
+
+	for i := range maxLevel {
+		if i >= s.level {
+			if s.head.next[i] != nil {
+				return fmt.Errorf("%w: unused level %d is linked", errInvariant, i)
+			}
+
+			continue
+		}
+
+		for current := s.head; current.next[i] != nil; current = current.next[i] {
+			next := current.next[i]
+
+			position, ok := positions[next]
+			if !ok {
+				return fmt.Errorf("%w: node %v on level %d isn't on the bottom level", errInvariant, next.key, i)
+			}
+
+			if current.span[i] != position-positions[current] {
+				return fmt.Errorf("%w: node at %d has span %d on level %d, expected %d",
+					errInvariant, positions[current], current.span[i], i, position-positions[current])
+			}
+		}
+	}
+
+	return nil
+}
+
+// Levels returns the number of levels of every node in sorted order, describing the list's shape.
+func (s *SkipList[K, V]) Levels() []int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var levels []int
+
+	for current := s.head.next[0]; current != nil; current = current.next[0] {
+		levels = append(levels, len(current.next))
+	}
+
+	return levels
+}
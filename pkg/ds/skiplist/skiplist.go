@@ -0,0 +1,344 @@
+// Package skiplist implements the skip list data structure.
+package skiplist
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"sync"
+)
+
+const (
+	// maxLevel is the most levels a node can have, enough for 4^32 keys.
+	maxLevel = 32
+
+	// levelBits is the number of random bits consumed per level. A node reaches the next
+	// level when they are all zero, so each level holds about a quarter of the one below.
+	levelBits = 2
+)
+
+// SkipList satisfies the ordered map interface.
+var _ OrderedMapper[int, int] = (*SkipList[int, int])(nil)
+
+// SkipList represents a skip list: a sorted linked list with express lanes on top, giving
+// O(log n) expected searches, insertions and deletions. Keys are ordered by cmp.Compare, so a
+// NaN key equals itself and sorts before every other key.
+// Mutex ensures the implementation of SkipList is thread-safe.
+type SkipList[K cmp.Ordered, V any] struct {
+	// head is a sentinel with maxLevel levels. Its key and value are unused.
+	head  *node[K, V]
+	level int // The number of levels in use, at least 1.
+	len   int
+	rng   *rand.Rand
+	mu    sync.Mutex
+}
+
+// node is a key and value linked into one or more levels. span[i] is the number of nodes
+// next[i] skips over on the bottom level, plus one, which is what makes rank queries fast.
+type node[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V]
+	span  []int
+}
+
+// New creates a new skip list with a randomly seeded source.
+func New[K cmp.Ordered, V any]() *SkipList[K, V] {
+	return NewWithSource[K, V](rand.NewPCG(rand.Uint64(), rand.Uint64()))
+}
+
+// NewWithSource creates a new skip list that draws node levels from source. A fixed seed
+// makes the list's shape, and so its performance, deterministic.
+func NewWithSource[K cmp.Ordered, V any](source rand.Source) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:  newNode[K, V](maxLevel),
+		level: 1,
+		rng:   rand.New(source),
+	}
+}
+
+// newNode creates a node with the given number of levels.
+func newNode[K cmp.Ordered, V any](levels int) *node[K, V] {
+	return &node[K, V]{
+		next: make([]*node[K, V], levels),
+		span: make([]int, levels),
+	}
+}
+
+// Insert sets the value for the key. It returns true if the key is new and false if an
+// existing value was replaced.
+func (s *SkipList[K, V]) Insert(key K, value V) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// update[i] is the last node before the key on level i and rank[i] is its position,
+	// counting the head as 0.
+	var (
+		update [maxLevel]*node[K, V]
+		rank   [maxLevel]int
+	)
+
+	current := s.head
+
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+
+		for current.next[i] != nil && cmp.Less(current.next[i].key, key) {
+			rank[i] += current.span[i]
+			current = current.next[i]
+		}
+
+		update[i] = current
+	}
+
+	if next := current.next[0]; next != nil && cmp.Compare(next.key, key) == 0 {
+		next.value = value
+
+		return false
+	}
+
+	levels := s.randomLevel()
+	if levels > s.level {
+		for i := s.level; i < levels; i++ {
+			update[i] = s.head
+			rank[i] = 0
+			s.head.span[i] = s.len
+		}
+
+		s.level = levels
+	}
+
+	inserted := newNode[K, V](levels)
+	inserted.key = key
+	inserted.value = value
+
+	for i := range levels {
+		inserted.next[i] = update[i].next[i]
+		update[i].next[i] = inserted
+
+		// rank[0]-rank[i] nodes lie between update[i] and the new node.
+		inserted.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+
+	// Higher levels now jump over one more node.
+	for i := levels; i < s.level; i++ {
+		update[i].span[i]++
+	}
+
+	s.len++
+
+	return true
+}
+
+// Search returns the value for the key and true, or the zero value and false if the key
+// isn't present.
+func (s *SkipList[K, V]) Search(key K) (V, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	found := s.seek(key)
+	if found == nil || cmp.Compare(found.key, key) != 0 {
+		var zero V
+
+		return zero, false
+	}
+
+	return found.value, true
+}
+
+// Delete removes the key. It returns false if the key isn't present.
+func (s *SkipList[K, V]) Delete(key K) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var update [maxLevel]*node[K, V]
+
+	current := s.head
+
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && cmp.Less(current.next[i].key, key) {
+			current = current.next[i]
+		}
+
+		update[i] = current
+	}
+
+	deleted := current.next[0]
+	if deleted == nil || cmp.Compare(deleted.key, key) != 0 {
+		return false
+	}
+
+	for i := range s.level {
+		if update[i].next[i] == deleted {
+			update[i].span[i] += deleted.span[i] - 1
+			update[i].next[i] = deleted.next[i]
+		} else {
+			update[i].span[i]--
+		}
+	}
+
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+
+	s.len--
+
+	return true
+}
+
+// Rank returns the number of keys less than the key, which is the key's index if it is
+// present, and whether it is present.
+func (s *SkipList[K, V]) Rank(key K) (int, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rank := 0
+	current := s.head
+
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && cmp.Less(current.next[i].key, key) {
+			rank += current.span[i]
+			current = current.next[i]
+		}
+	}
+
+	next := current.next[0]
+
+	return rank, next != nil && cmp.Compare(next.key, key) == 0
+}
+
+// At returns the key and value at the index in sorted order.
+func (s *SkipList[K, V]) At(index int) (K, V, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if index < 0 || index >= s.len {
+		var (
+			zeroKey   K
+			zeroValue V
+		)
+
+		return zeroKey, zeroValue, fmt.Errorf("%w: %d", ErrIndexOutOfRange, index)
+	}
+
+	// The head is position 0, so the node at the index is at position index+1.
+	traversed := 0
+	current := s.head
+
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && traversed+current.span[i] <= index+1 {
+			traversed += current.span[i]
+			current = current.next[i]
+		}
+
+		if traversed == index+1 {
+			break
+		}
+	}
+
+	return current.key, current.value, nil
+}
+
+// Range returns an iterator over the keys in [from, to) and their values in sorted order.
+// The keys are read under the lock when iteration starts, so the skip list may be modified
+// while iterating.
+func (s *SkipList[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, entry := range s.snapshot(&from, func(key K) bool { return cmp.Less(key, to) }) {
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the keys and values in sorted order.
+// The keys are read under the lock when iteration starts, so the skip list may be modified
+// while iterating.
+func (s *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, entry := range s.snapshot(nil, func(K) bool { return true }) {
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes every key.
+func (s *SkipList[K, V]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.head = newNode[K, V](maxLevel)
+	s.level = 1
+	s.len = 0
+}
+
+// IsEmpty returns true if the skip list holds no keys.
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Len returns the number of keys.
+func (s *SkipList[K, V]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.len
+}
+
+// entry is a copy of a node's key and value.
+type entry[K cmp.Ordered, V any] struct {
+	key   K
+	value V
+}
+
+// snapshot copies the entries from the first key not less than from, or from the smallest
+// key if from is nil, while keep holds.
+func (s *SkipList[K, V]) snapshot(from *K, keep func(K) bool) []entry[K, V] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	start := s.head.next[0]
+	if from != nil {
+		start = s.seek(*from)
+	}
+
+	var entries []entry[K, V]
+
+	for current := start; current != nil && keep(current.key); current = current.next[0] {
+		entries = append(entries, entry[K, V]{key: current.key, value: current.value})
+	}
+
+	return entries
+}
+
+// seek returns the first node whose key isn't less than the key, or nil if there is none.
+func (s *SkipList[K, V]) seek(key K) *node[K, V] {
+	current := s.head
+
+	for i := s.level - 1; i >= 0; i-- {
+		for current.next[i] != nil && cmp.Less(current.next[i].key, key) {
+			current = current.next[i]
+		}
+	}
+
+	return current.next[0]
+}
+
+// randomLevel returns a random number of levels for a new node. Each extra level has a
+// probability of 1/4.
+func (s *SkipList[K, V]) randomLevel() int {
+	levels := 1
+
+	for bits := s.rng.Uint64(); levels < maxLevel && bits&(1<<levelBits-1) == 0; bits >>= levelBits {
+		levels++
+	}
+
+	return levels
+}
@@ -0,0 +1,25 @@
+// Package skiplist implements the skip list data structure.
+package skiplist
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+)
+
+// ErrIndexOutOfRange is an error indicating an index that doesn't hold a key.
+var ErrIndexOutOfRange = errors.New("index out of range")
+
+// OrderedMapper defines the operations for a map that keeps its keys sorted.
+type OrderedMapper[K cmp.Ordered, V any] interface {
+	Insert(key K, value V) bool
+	Search(key K) (V, bool)
+	Delete(key K) bool
+	Rank(key K) (int, bool)
+	At(index int) (K, V, error)
+	Range(from, to K) iter.Seq2[K, V]
+	All() iter.Seq2[K, V]
+	Clear()
+	IsEmpty() bool
+	Len() int
+}
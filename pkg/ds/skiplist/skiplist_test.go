@@ -0,0 +1,290 @@
+package skiplist_test
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/skiplist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type SkipListTestSuite struct {
+	suite.Suite
+	list *skiplist.SkipList[int, string]
+}
+
+func TestSkipListTestSuite(t *testing.T) {
+	suite.Run(t, new(SkipListTestSuite))
+}
+
+func (suite *SkipListTestSuite) SetupTest() {
+	suite.list = skiplist.NewWithSource[int, string](rand.NewPCG(1, 2))
+}
+
+func (suite *SkipListTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.list.CheckInvariants())
+}
+
+// fill inserts the keys, each with its name as the value.
+func (suite *SkipListTestSuite) fill(keys ...int) {
+	for _, key := range keys {
+		suite.list.Insert(key, name(key))
+	}
+}
+
+// name returns a value for the key.
+func name(key int) string {
+	return string(rune('a' + key%26))
+}
+
+// keys collects the keys produced by an iterator.
+func keys(seq func(yield func(int, string) bool)) []int {
+	var collected []int
+
+	for key := range seq {
+		collected = append(collected, key)
+	}
+
+	return collected
+}
+
+func (suite *SkipListTestSuite) TestNew() {
+	list := skiplist.New[string, int]()
+
+	assert.True(suite.T(), list.IsEmpty())
+	assert.Equal(suite.T(), 0, list.Len())
+	assert.NoError(suite.T(), list.CheckInvariants())
+}
+
+func (suite *SkipListTestSuite) TestInsertAndSearch() {
+	assert.True(suite.T(), suite.list.Insert(2, "two"))
+	assert.True(suite.T(), suite.list.Insert(1, "one"))
+
+	value, found := suite.list.Search(2)
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), "two", value)
+
+	value, found = suite.list.Search(3)
+	assert.False(suite.T(), found)
+	assert.Zero(suite.T(), value)
+	assert.Equal(suite.T(), 2, suite.list.Len())
+}
+
+func (suite *SkipListTestSuite) TestInsertReplaces() {
+	suite.list.Insert(1, "one")
+
+	assert.False(suite.T(), suite.list.Insert(1, "uno"))
+
+	value, _ := suite.list.Search(1)
+	assert.Equal(suite.T(), "uno", value)
+	assert.Equal(suite.T(), 1, suite.list.Len())
+}
+
+func (suite *SkipListTestSuite) TestDelete() {
+	suite.fill(1, 2, 3)
+
+	assert.True(suite.T(), suite.list.Delete(2))
+	assert.False(suite.T(), suite.list.Delete(2))
+	assert.False(suite.T(), suite.list.Delete(4))
+
+	_, found := suite.list.Search(2)
+	assert.False(suite.T(), found)
+	assert.Equal(suite.T(), []int{1, 3}, keys(suite.list.All()))
+}
+
+func (suite *SkipListTestSuite) TestNaNKey() {
+	list := skiplist.New[float64, string]()
+	nan := math.NaN()
+
+	assert.True(suite.T(), list.Insert(1, "one"))
+	assert.True(suite.T(), list.Insert(nan, "first"))
+	assert.False(suite.T(), list.Insert(nan, "second"))
+	assert.Equal(suite.T(), 2, list.Len())
+	require.NoError(suite.T(), list.CheckInvariants())
+
+	value, found := list.Search(nan)
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), "second", value)
+
+	// NaN sorts before every other key.
+	rank, found := list.Rank(nan)
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), 0, rank)
+
+	assert.True(suite.T(), list.Delete(nan))
+	assert.False(suite.T(), list.Delete(nan))
+	assert.Equal(suite.T(), 1, list.Len())
+	require.NoError(suite.T(), list.CheckInvariants())
+}
+
+func (suite *SkipListTestSuite) TestAllIsSorted() {
+	suite.fill(5, 3, 9, 1, 7)
+
+	assert.Equal(suite.T(), []int{1, 3, 5, 7, 9}, keys(suite.list.All()))
+}
+
+func (suite *SkipListTestSuite) TestAllStopsEarly() {
+	suite.fill(1, 2, 3)
+
+	var visited []int
+
+	for key := range suite.list.All() {
+		visited = append(visited, key)
+		if key == 2 {
+			break
+		}
+	}
+
+	assert.Equal(suite.T(), []int{1, 2}, visited)
+}
+
+func (suite *SkipListTestSuite) TestRange() {
+	suite.fill(1, 3, 5, 7, 9)
+
+	assert.Equal(suite.T(), []int{3, 5, 7}, keys(suite.list.Range(2, 9)))
+	assert.Equal(suite.T(), []int{1, 3}, keys(suite.list.Range(0, 4)))
+	assert.Empty(suite.T(), keys(suite.list.Range(4, 5)))
+	assert.Empty(suite.T(), keys(suite.list.Range(7, 3)))
+}
+
+func (suite *SkipListTestSuite) TestRangeValues() {
+	suite.fill(1, 2)
+
+	for key, value := range suite.list.Range(1, 3) {
+		assert.Equal(suite.T(), name(key), value)
+	}
+}
+
+func (suite *SkipListTestSuite) TestRank() {
+	suite.fill(10, 20, 30)
+
+	rank, found := suite.list.Rank(20)
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), 1, rank)
+
+	rank, found = suite.list.Rank(25)
+	assert.False(suite.T(), found)
+	assert.Equal(suite.T(), 2, rank)
+
+	rank, found = suite.list.Rank(5)
+	assert.False(suite.T(), found)
+	assert.Equal(suite.T(), 0, rank)
+}
+
+func (suite *SkipListTestSuite) TestAt() {
+	suite.fill(10, 20, 30)
+
+	key, value, err := suite.list.At(2)
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 30, key)
+	assert.Equal(suite.T(), name(30), value)
+
+	for _, index := range []int{-1, 3} {
+		key, value, err = suite.list.At(index)
+
+		assert.Zero(suite.T(), key)
+		assert.Zero(suite.T(), value)
+		assert.ErrorIs(suite.T(), err, skiplist.ErrIndexOutOfRange)
+	}
+}
+
+func (suite *SkipListTestSuite) TestClear() {
+	suite.fill(1, 2, 3)
+	suite.list.Clear()
+
+	assert.True(suite.T(), suite.list.IsEmpty())
+	assert.Empty(suite.T(), keys(suite.list.All()))
+
+	suite.fill(4)
+	assert.Equal(suite.T(), []int{4}, keys(suite.list.All()))
+}
+
+func (suite *SkipListTestSuite) TestSameSeedSameShape() {
+	other := skiplist.NewWithSource[int, string](rand.NewPCG(1, 2))
+
+	for key := range 200 {
+		suite.list.Insert(key, name(key))
+		other.Insert(key, name(key))
+	}
+
+	assert.Equal(suite.T(), suite.list.Levels(), other.Levels())
+}
+
+func (suite *SkipListTestSuite) TestAgainstModel() {
+	rng := rand.New(rand.NewPCG(3, 4))
+	model := map[int]string{}
+
+	for step := range 5000 {
+		key := rng.IntN(300)
+
+		switch rng.IntN(4) {
+		case 0, 1:
+			_, exists := model[key]
+			require.Equal(suite.T(), !exists, suite.list.Insert(key, name(step)))
+
+			model[key] = name(step)
+		case 2:
+			_, exists := model[key]
+			require.Equal(suite.T(), exists, suite.list.Delete(key))
+
+			delete(model, key)
+		default:
+			value, found := suite.list.Search(key)
+			expected, exists := model[key]
+			require.Equal(suite.T(), exists, found)
+			require.Equal(suite.T(), expected, value)
+		}
+
+		require.NoError(suite.T(), suite.list.CheckInvariants(), "step %d", step)
+	}
+
+	sorted := make([]int, 0, len(model))
+	for key := range model {
+		sorted = append(sorted, key)
+	}
+
+	slices.Sort(sorted)
+
+	require.Equal(suite.T(), sorted, keys(suite.list.All()))
+
+	for index, key := range sorted {
+		rank, found := suite.list.Rank(key)
+		require.True(suite.T(), found)
+		require.Equal(suite.T(), index, rank)
+
+		atKey, value, err := suite.list.At(index)
+		require.NoError(suite.T(), err)
+		require.Equal(suite.T(), key, atKey)
+		require.Equal(suite.T(), model[key], value)
+	}
+
+	from, to := 50, 150
+	lower, _ := slices.BinarySearch(sorted, from)
+	upper, _ := slices.BinarySearch(sorted, to)
+	assert.Equal(suite.T(), sorted[lower:upper], keys(suite.list.Range(from, to)))
+}
+
+func (suite *SkipListTestSuite) TestConcurrentInserts() {
+	var wg sync.WaitGroup
+
+	for worker := range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range 100 {
+				suite.list.Insert(worker*100+i, name(i))
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(suite.T(), 800, suite.list.Len())
+}
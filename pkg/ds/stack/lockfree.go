@@ -0,0 +1,123 @@
+// Package stack implements the stack data structure.
+package stack
+
+import (
+	"iter"
+	"slices"
+	"sync/atomic"
+)
+
+// LockFree satisfies the stack interface.
+var _ Stacker[int] = (*LockFree[int])(nil)
+
+// LockFree represents a lock-free stack, also known as a Treiber stack. Push and Pop swap
+// the top node with a compare-and-swap instead of taking a lock, so goroutines never block
+// each other. Nodes are never reused, and the garbage collector keeps a node alive while any
+// goroutine still holds it, which rules out the ABA problem.
+type LockFree[T comparable] struct {
+	top atomic.Pointer[lockFreeNode[T]]
+}
+
+// lockFreeNode is an immutable node of a LockFree stack.
+type lockFreeNode[T comparable] struct {
+	value T
+	next  *lockFreeNode[T]
+	depth int // The number of nodes from this one to the bottom, so Len is O(1).
+}
+
+// NewLockFree creates a new lock-free stack.
+func NewLockFree[T comparable]() *LockFree[T] {
+	return &LockFree[T]{}
+}
+
+// CollectLockFree creates a new lock-free stack by pushing the values produced by seq in
+// order, so the last value ends up on top.
+func CollectLockFree[T comparable](seq iter.Seq[T]) *LockFree[T] {
+	newStack := NewLockFree[T]()
+
+	for value := range seq {
+		newStack.Push(value)
+	}
+
+	return newStack
+}
+
+// Push adds an item to the top of the stack.
+func (s *LockFree[T]) Push(value T) {
+	node := &lockFreeNode[T]{value: value}
+
+	for {
+		top := s.top.Load()
+		node.next = top
+		node.depth = 1
+
+		if top != nil {
+			node.depth = top.depth + 1
+		}
+
+		if s.top.CompareAndSwap(top, node) {
+			return
+		}
+	}
+}
+
+// Pop removes the item from the top of the stack and returns it.
+func (s *LockFree[T]) Pop() (*T, error) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			return nil, ErrEmptyStack
+		}
+
+		if s.top.CompareAndSwap(top, top.next) {
+			item := top.value
+
+			return &item, nil
+		}
+	}
+}
+
+// Peek returns the item at the top of the stack without removing it from the stack.
+func (s *LockFree[T]) Peek() (*T, error) {
+	top := s.top.Load()
+	if top == nil {
+		return nil, ErrEmptyStack
+	}
+
+	item := top.value
+
+	return &item, nil
+}
+
+// IsEmpty returns true if the stack is empty. Otherwise returns false.
+func (s *LockFree[T]) IsEmpty() bool {
+	return s.top.Load() == nil
+}
+
+// Len returns the length of the stack.
+func (s *LockFree[T]) Len() int {
+	top := s.top.Load()
+	if top == nil {
+		return 0
+	}
+
+	return top.depth
+}
+
+// All returns an iterator over the items in the stack from the bottom to the top.
+// The nodes are immutable, so the stack as it was when iteration starts is read without
+// blocking, and the stack may be modified while iterating.
+func (s *LockFree[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		var items []T
+		for node := s.top.Load(); node != nil; node = node.next {
+			items = append(items, node.value)
+		}
+
+		for _, item := range slices.Backward(items) {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package stack_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/stack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockFreeConcurrentPushPop(t *testing.T) {
+	goroutines := 8
+	perGoroutine := 2000
+
+	lockFree := stack.NewLockFree[int]()
+	popped := make([][]int, goroutines)
+
+	var wg sync.WaitGroup
+
+	for g := range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range perGoroutine {
+				lockFree.Push(g*perGoroutine + i)
+
+				if i%2 == 1 {
+					if item, err := lockFree.Pop(); err == nil {
+						popped[g] = append(popped[g], *item)
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Every pushed value must come out exactly once, whether popped or still on the stack.
+	seen := make([]int, goroutines*perGoroutine)
+
+	for _, items := range popped {
+		for _, item := range items {
+			seen[item]++
+		}
+	}
+
+	remaining := lockFree.Len()
+
+	for !lockFree.IsEmpty() {
+		item, err := lockFree.Pop()
+		require.NoError(t, err)
+
+		seen[*item]++
+		remaining--
+	}
+
+	assert.Zero(t, remaining)
+
+	for value, count := range seen {
+		require.Equal(t, 1, count, "value %d", value)
+	}
+}
+
+func TestLockFreeAllIsASnapshot(t *testing.T) {
+	lockFree := stack.NewLockFree[int]()
+	lockFree.Push(1)
+	lockFree.Push(2)
+
+	var visited []int
+
+	// Modifying the stack while iterating must not change the iteration.
+	for item := range lockFree.All() {
+		lockFree.Push(item * 10)
+		visited = append(visited, item)
+	}
+
+	assert.Equal(t, []int{1, 2}, visited)
+	assert.Equal(t, 4, lockFree.Len())
+}
+
+// BenchmarkContention pushes and pops from every available goroutine at once.
+func BenchmarkContention(b *testing.B) {
+	b.Run("Mutex", func(b *testing.B) {
+		benchmarkContention(b, stack.New[int]())
+	})
+
+	b.Run("LockFree", func(b *testing.B) {
+		benchmarkContention(b, stack.NewLockFree[int]())
+	})
+}
+
+// benchmarkContention runs a push followed by a pop on the stack from parallel goroutines.
+func benchmarkContention(b *testing.B, contended stack.Stacker[int]) {
+	b.Helper()
+	b.ReportAllocs()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			contended.Push(1)
+			_, _ = contended.Pop()
+		}
+	})
+}
@@ -0,0 +1,102 @@
+// Package stack implements the stack data structure.
+package stack
+
+import (
+	"cmp"
+	"sync"
+)
+
+// MinMaxStack satisfies the stack interface.
+var _ Stacker[int] = (*MinMaxStack[int])(nil)
+
+// MinMaxStack represents a stack that also reports its smallest and largest items in O(1).
+// Mutex ensures the implementation of MinMaxStack is thread-safe.
+type MinMaxStack[T cmp.Ordered] struct {
+	// entries records, next to every item, the smallest and largest items at or below it,
+	// so popping an item restores the previous minimum and maximum for free.
+	entries []minMaxEntry[T]
+	mu      sync.Mutex
+}
+
+// minMaxEntry is an item with the smallest and largest items at or below it.
+type minMaxEntry[T cmp.Ordered] struct {
+	value, min, max T
+}
+
+// NewMinMax creates a new min/max stack.
+func NewMinMax[T cmp.Ordered]() *MinMaxStack[T] {
+	return &MinMaxStack[T]{}
+}
+
+// Push adds an item to the top of the stack.
+func (s *MinMaxStack[T]) Push(value T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry := minMaxEntry[T]{value: value, min: value, max: value}
+
+	if len(s.entries) > 0 {
+		top := s.entries[len(s.entries)-1]
+		entry.min = min(top.min, value)
+		entry.max = max(top.max, value)
+	}
+
+	s.entries = append(s.entries, entry)
+}
+
+// Pop removes the item from the top of the stack and returns it.
+func (s *MinMaxStack[T]) Pop() (*T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.entries) == 0 {
+		return nil, ErrEmptyStack
+	}
+
+	item := s.entries[len(s.entries)-1].value
+	s.entries = s.entries[:len(s.entries)-1]
+
+	return &item, nil
+}
+
+// Peek returns the item at the top of the stack without removing it from the stack.
+func (s *MinMaxStack[T]) Peek() (*T, error) {
+	return s.top(func(entry minMaxEntry[T]) T { return entry.value })
+}
+
+// Min returns the smallest item in the stack.
+func (s *MinMaxStack[T]) Min() (*T, error) {
+	return s.top(func(entry minMaxEntry[T]) T { return entry.min })
+}
+
+// Max returns the largest item in the stack.
+func (s *MinMaxStack[T]) Max() (*T, error) {
+	return s.top(func(entry minMaxEntry[T]) T { return entry.max })
+}
+
+// IsEmpty returns true if the stack is empty. Otherwise returns false.
+func (s *MinMaxStack[T]) IsEmpty() bool {
+	return s.Len() == 0
+}
+
+// Len returns the length of the stack.
+func (s *MinMaxStack[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.entries)
+}
+
+// top returns the field of the top entry chosen by field.
+func (s *MinMaxStack[T]) top(field func(minMaxEntry[T]) T) (*T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.entries) == 0 {
+		return nil, ErrEmptyStack
+	}
+
+	item := field(s.entries[len(s.entries)-1])
+
+	return &item, nil
+}
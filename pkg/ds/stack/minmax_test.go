@@ -0,0 +1,126 @@
+package stack_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/stack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type MinMaxStackTestSuite struct {
+	suite.Suite
+	stack *stack.MinMaxStack[int]
+}
+
+func TestMinMaxStackTestSuite(t *testing.T) {
+	suite.Run(t, new(MinMaxStackTestSuite))
+}
+
+func (suite *MinMaxStackTestSuite) SetupTest() {
+	suite.stack = stack.NewMinMax[int]()
+}
+
+func (suite *MinMaxStackTestSuite) TestEmpty() {
+	assert.True(suite.T(), suite.stack.IsEmpty())
+
+	for _, query := range []func() (*int, error){suite.stack.Pop, suite.stack.Peek, suite.stack.Min, suite.stack.Max} {
+		item, err := query()
+
+		assert.Nil(suite.T(), item)
+		assert.ErrorIs(suite.T(), err, stack.ErrEmptyStack)
+	}
+}
+
+func (suite *MinMaxStackTestSuite) TestMinAndMaxFollowPops() {
+	suite.stack.Push(3)
+	suite.stack.Push(1)
+	suite.stack.Push(4)
+
+	suite.assertMinMax(1, 4)
+
+	top, err := suite.stack.Pop()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 4, *top)
+	suite.assertMinMax(1, 3)
+
+	_, err = suite.stack.Pop()
+	require.NoError(suite.T(), err)
+	suite.assertMinMax(3, 3)
+}
+
+func (suite *MinMaxStackTestSuite) TestDuplicates() {
+	suite.stack.Push(2)
+	suite.stack.Push(2)
+
+	_, err := suite.stack.Pop()
+	require.NoError(suite.T(), err)
+	suite.assertMinMax(2, 2)
+}
+
+func (suite *MinMaxStackTestSuite) TestAgainstModel() {
+	rng := rand.New(rand.NewPCG(1, 1))
+
+	var model []int
+
+	for range 2000 {
+		if len(model) > 0 && rng.IntN(3) == 0 {
+			item, err := suite.stack.Pop()
+			require.NoError(suite.T(), err)
+			require.Equal(suite.T(), model[len(model)-1], *item)
+
+			model = model[:len(model)-1]
+		} else {
+			value := rng.IntN(1000) - 500
+			suite.stack.Push(value)
+
+			model = append(model, value)
+		}
+
+		require.Equal(suite.T(), len(model), suite.stack.Len())
+
+		if len(model) > 0 {
+			suite.assertMinMax(slices.Min(model), slices.Max(model))
+		}
+	}
+}
+
+func (suite *MinMaxStackTestSuite) TestConcurrentAccess() {
+	goroutines := 8
+	perGoroutine := 500
+
+	var wg sync.WaitGroup
+
+	for g := range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range perGoroutine {
+				suite.stack.Push(g*perGoroutine + i)
+				_, _ = suite.stack.Min()
+				_, _ = suite.stack.Max()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	suite.assertMinMax(0, goroutines*perGoroutine-1)
+}
+
+// assertMinMax checks the stack's smallest and largest items.
+func (suite *MinMaxStackTestSuite) assertMinMax(expectedMin, expectedMax int) {
+	smallest, err := suite.stack.Min()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedMin, *smallest)
+
+	largest, err := suite.stack.Max()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), expectedMax, *largest)
+}
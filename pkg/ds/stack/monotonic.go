@@ -0,0 +1,97 @@
+// Package stack implements the stack data structure.
+package stack
+
+import "cmp"
+
+// MonotonicStack represents a stack whose items stay in order from the bottom to the top.
+// Pushing an item first pops every item that may not stay below it, which is the core of
+// next-greater-element, sliding-window and histogram scans.
+// MonotonicStack is meant as scratch space inside a single algorithm and isn't thread-safe.
+type MonotonicStack[T any] struct {
+	items []T
+	// keep reports whether below may stay under above.
+	keep func(below, above T) bool
+}
+
+// NewMonotonic creates a new monotonic stack. keep reports whether the item below may stay
+// under the item above; for example, (below > above) keeps the items strictly decreasing.
+func NewMonotonic[T any](keep func(below, above T) bool) *MonotonicStack[T] {
+	return &MonotonicStack[T]{keep: keep}
+}
+
+// Push pops every item that may not stay below the value, then pushes the value. It returns
+// the popped items, top first.
+func (s *MonotonicStack[T]) Push(value T) []T {
+	var popped []T
+
+	for len(s.items) > 0 && !s.keep(s.items[len(s.items)-1], value) {
+		popped = append(popped, s.items[len(s.items)-1])
+		s.items = s.items[:len(s.items)-1]
+	}
+
+	s.items = append(s.items, value)
+
+	return popped
+}
+
+// Pop removes the item from the top of the stack and returns it.
+func (s *MonotonicStack[T]) Pop() (*T, error) {
+	if len(s.items) == 0 {
+		return nil, ErrEmptyStack
+	}
+
+	item := s.items[len(s.items)-1]
+	s.items = s.items[:len(s.items)-1]
+
+	return &item, nil
+}
+
+// Peek returns the item at the top of the stack without removing it from the stack.
+func (s *MonotonicStack[T]) Peek() (*T, error) {
+	if len(s.items) == 0 {
+		return nil, ErrEmptyStack
+	}
+
+	item := s.items[len(s.items)-1]
+
+	return &item, nil
+}
+
+// IsEmpty returns true if the stack is empty. Otherwise returns false.
+func (s *MonotonicStack[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Len returns the length of the stack.
+func (s *MonotonicStack[T]) Len() int {
+	return len(s.items)
+}
+
+// NextGreater returns, for every value, the index of the next value to its right that is
+// greater than it, or -1 if there is none.
+func NextGreater[T cmp.Ordered](values []T) []int {
+	return nextIndexes(values, func(below, above T) bool { return below >= above })
+}
+
+// NextSmaller returns, for every value, the index of the next value to its right that is
+// smaller than it, or -1 if there is none.
+func NextSmaller[T cmp.Ordered](values []T) []int {
+	return nextIndexes(values, func(below, above T) bool { return below <= above })
+}
+
+// nextIndexes returns, for every value, the index of the first value to its right that
+// pops it from a monotonic stack ordered by keep, or -1 if nothing does.
+func nextIndexes[T any](values []T, keep func(below, above T) bool) []int {
+	next := make([]int, len(values))
+	pending := NewMonotonic(func(below, above int) bool { return keep(values[below], values[above]) })
+
+	for i := range values {
+		next[i] = -1
+
+		for _, popped := range pending.Push(i) {
+			next[popped] = i
+		}
+	}
+
+	return next
+}
@@ -0,0 +1,118 @@
+package stack_test
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/stack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type MonotonicStackTestSuite struct {
+	suite.Suite
+}
+
+func TestMonotonicStackTestSuite(t *testing.T) {
+	suite.Run(t, new(MonotonicStackTestSuite))
+}
+
+func (suite *MonotonicStackTestSuite) TestPushPopsOutOfOrderItems() {
+	decreasing := stack.NewMonotonic(func(below, above int) bool { return below > above })
+
+	assert.Empty(suite.T(), decreasing.Push(5))
+	assert.Empty(suite.T(), decreasing.Push(3))
+	assert.Empty(suite.T(), decreasing.Push(1))
+	assert.Equal(suite.T(), []int{1, 3}, decreasing.Push(4))
+	assert.Equal(suite.T(), 2, decreasing.Len())
+
+	top, err := decreasing.Peek()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 4, *top)
+
+	top, err = decreasing.Pop()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 4, *top)
+
+	_, err = decreasing.Pop()
+	require.NoError(suite.T(), err)
+	assert.True(suite.T(), decreasing.IsEmpty())
+
+	_, err = decreasing.Pop()
+	require.ErrorIs(suite.T(), err, stack.ErrEmptyStack)
+
+	_, err = decreasing.Peek()
+	require.ErrorIs(suite.T(), err, stack.ErrEmptyStack)
+}
+
+func (suite *MonotonicStackTestSuite) TestNextGreater() {
+	assert.Equal(suite.T(), []int{1, 3, 3, -1, -1}, stack.NextGreater([]int{2, 3, 1, 5, 5}))
+	assert.Empty(suite.T(), stack.NextGreater([]int{}))
+}
+
+func (suite *MonotonicStackTestSuite) TestNextSmaller() {
+	assert.Equal(suite.T(), []int{2, 2, -1, 4, -1}, stack.NextSmaller([]string{"b", "c", "a", "d", "c"}))
+}
+
+func (suite *MonotonicStackTestSuite) TestAgainstBruteForce() {
+	rng := rand.New(rand.NewPCG(2, 2))
+
+	values := make([]int, 300)
+	for i := range values {
+		values[i] = rng.IntN(20)
+	}
+
+	greater := stack.NextGreater(values)
+	smaller := stack.NextSmaller(values)
+
+	for i, value := range values {
+		assert.Equal(suite.T(), firstAfter(values, i, func(other int) bool { return other > value }), greater[i])
+		assert.Equal(suite.T(), firstAfter(values, i, func(other int) bool { return other < value }), smaller[i])
+	}
+}
+
+// TestLargestRectangle finds the largest rectangle in a histogram, bounding each bar by the
+// nearest smaller bars on either side.
+func (suite *MonotonicStackTestSuite) TestLargestRectangle() {
+	heights := []int{2, 1, 5, 6, 2, 3}
+
+	right := stack.NextSmaller(heights)
+
+	// Scanning the reversed heights finds the previous smaller bars.
+	reversed := make([]int, len(heights))
+	for i, height := range heights {
+		reversed[len(heights)-1-i] = height
+	}
+
+	left := stack.NextSmaller(reversed)
+
+	largest := 0
+
+	for i, height := range heights {
+		end := right[i]
+		if end == -1 {
+			end = len(heights)
+		}
+
+		start := -1
+		if mirrored := left[len(heights)-1-i]; mirrored != -1 {
+			start = len(heights) - 1 - mirrored
+		}
+
+		largest = max(largest, height*(end-start-1))
+	}
+
+	assert.Equal(suite.T(), 10, largest)
+}
+
+// firstAfter returns the index of the first value after i that matches, or -1.
+func firstAfter(values []int, i int, match func(int) bool) int {
+	for j := i + 1; j < len(values); j++ {
+		if match(values[j]) {
+			return j
+		}
+	}
+
+	return -1
+}
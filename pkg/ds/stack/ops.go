@@ -0,0 +1,165 @@
+// Package stack implements the stack data structure.
+package stack
+
+import "fmt"
+
+// The operations in this file take the lock once, so concurrent users see each of them
+// happen all at once. The stack effect of the Forth-style operations is shown with the
+// top of the stack on the right.
+
+// PushAll pushes the values in order, so the last value ends up on top.
+func (s *Stack[T]) PushAll(values ...T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items = append(s.items, values...)
+}
+
+// PopN removes n items from the top of the stack and returns them in the order they were
+// popped, top first. If the stack holds fewer than n items it returns an error and removes
+// nothing.
+func (s *Stack[T]) PopN(n int) ([]T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if n < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidCount, n)
+	}
+
+	if err := s.require(n); err != nil {
+		return nil, err
+	}
+
+	popped := make([]T, n)
+	for i := range n {
+		popped[i] = s.items[len(s.items)-1-i]
+	}
+
+	clear(s.items[len(s.items)-n:])
+	s.items = s.items[:len(s.items)-n]
+
+	return popped, nil
+}
+
+// DropBottom removes the n oldest items from the bottom of the stack, which bounds a stack
+// used as a history. It takes time in proportion to n, not to the size of the stack. If the
+// stack holds fewer than n items it returns an error and removes nothing.
+func (s *Stack[T]) DropBottom(n int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if n < 0 {
+		return fmt.Errorf("%w: %d", ErrInvalidCount, n)
+	}
+
+	if err := s.require(n); err != nil {
+		return err
+	}
+
+	// Reslicing leaves the dropped slots at the front of the backing array; the next append
+	// that outgrows it copies only the remaining items.
+	clear(s.items[:n])
+	s.items = s.items[n:]
+
+	return nil
+}
+
+// PeekAt returns the item at the depth without removing it. The top of the stack is at depth 0.
+func (s *Stack[T]) PeekAt(depth int) (*T, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if depth < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidCount, depth)
+	}
+
+	// Compare with depth itself, since depth + 1 overflows for math.MaxInt.
+	if depth >= len(s.items) {
+		return nil, fmt.Errorf("%w: depth %d, have %d", ErrNotEnoughItems, depth, len(s.items))
+	}
+
+	item := s.items[len(s.items)-1-depth]
+
+	return &item, nil
+}
+
+// Swap exchanges the top two items: ( a b -- b a ).
+func (s *Stack[T]) Swap() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.require(2); err != nil {
+		return err
+	}
+
+	top := len(s.items) - 1
+	s.items[top], s.items[top-1] = s.items[top-1], s.items[top]
+
+	return nil
+}
+
+// Dup pushes a copy of the top item: ( a -- a a ).
+func (s *Stack[T]) Dup() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.require(1); err != nil {
+		return err
+	}
+
+	s.items = append(s.items, s.items[len(s.items)-1])
+
+	return nil
+}
+
+// Over pushes a copy of the second item: ( a b -- a b a ).
+func (s *Stack[T]) Over() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.require(2); err != nil {
+		return err
+	}
+
+	s.items = append(s.items, s.items[len(s.items)-2])
+
+	return nil
+}
+
+// Rot moves the third item to the top: ( a b c -- b c a ).
+func (s *Stack[T]) Rot() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.require(3); err != nil {
+		return err
+	}
+
+	top := len(s.items) - 1
+	s.items[top-2], s.items[top-1], s.items[top] = s.items[top-1], s.items[top], s.items[top-2]
+
+	return nil
+}
+
+// Clear removes every item from the stack.
+func (s *Stack[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	clear(s.items)
+	s.items = s.items[:0]
+}
+
+// ToSlice returns a copy of the items in the stack from the bottom to the top.
+func (s *Stack[T]) ToSlice() []T {
+	return s.snapshot()
+}
+
+// require returns an error if the stack holds fewer than n items. The lock must be held.
+func (s *Stack[T]) require(n int) error {
+	if len(s.items) < n {
+		return fmt.Errorf("%w: need %d, have %d", ErrNotEnoughItems, n, len(s.items))
+	}
+
+	return nil
+}
@@ -0,0 +1,230 @@
+package stack_test
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/stack"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StackOpsTestSuite struct {
+	suite.Suite
+	stack *stack.Stack[int]
+}
+
+func TestStackOpsTestSuite(t *testing.T) {
+	suite.Run(t, new(StackOpsTestSuite))
+}
+
+func (suite *StackOpsTestSuite) SetupTest() {
+	suite.stack = stack.New[int]()
+}
+
+func (suite *StackOpsTestSuite) TestPushAll() {
+	suite.stack.Push(1)
+	suite.stack.PushAll(2, 3)
+	suite.stack.PushAll()
+
+	top, err := suite.stack.Peek()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, *top)
+	assert.Equal(suite.T(), []int{1, 2, 3}, suite.stack.ToSlice())
+}
+
+func (suite *StackOpsTestSuite) TestPopN() {
+	suite.stack.PushAll(1, 2, 3, 4)
+
+	popped, err := suite.stack.PopN(3)
+
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), []int{4, 3, 2}, popped)
+	assert.Equal(suite.T(), []int{1}, suite.stack.ToSlice())
+}
+
+func (suite *StackOpsTestSuite) TestPopNZero() {
+	popped, err := suite.stack.PopN(0)
+
+	require.NoError(suite.T(), err)
+	assert.Empty(suite.T(), popped)
+}
+
+func (suite *StackOpsTestSuite) TestPopNIsAllOrNothing() {
+	suite.stack.PushAll(1, 2)
+
+	popped, err := suite.stack.PopN(3)
+	assert.Nil(suite.T(), popped)
+	require.ErrorIs(suite.T(), err, stack.ErrNotEnoughItems)
+
+	popped, err = suite.stack.PopN(-1)
+	assert.Nil(suite.T(), popped)
+	require.ErrorIs(suite.T(), err, stack.ErrInvalidCount)
+
+	assert.Equal(suite.T(), []int{1, 2}, suite.stack.ToSlice())
+}
+
+func (suite *StackOpsTestSuite) TestDropBottom() {
+	suite.stack.PushAll(1, 2, 3, 4)
+
+	require.NoError(suite.T(), suite.stack.DropBottom(2))
+	assert.Equal(suite.T(), []int{3, 4}, suite.stack.ToSlice())
+
+	require.ErrorIs(suite.T(), suite.stack.DropBottom(3), stack.ErrNotEnoughItems)
+	require.ErrorIs(suite.T(), suite.stack.DropBottom(-1), stack.ErrInvalidCount)
+	assert.Equal(suite.T(), []int{3, 4}, suite.stack.ToSlice())
+
+	// Pushing after a drop keeps the order.
+	suite.stack.PushAll(5, 6, 7)
+	require.NoError(suite.T(), suite.stack.DropBottom(1))
+	assert.Equal(suite.T(), []int{4, 5, 6, 7}, suite.stack.ToSlice())
+
+	top, err := suite.stack.Pop()
+	require.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 7, *top)
+}
+
+func (suite *StackOpsTestSuite) TestPeekAt() {
+	suite.stack.PushAll(1, 2, 3)
+
+	for depth, expected := range []int{3, 2, 1} {
+		item, err := suite.stack.PeekAt(depth)
+
+		require.NoError(suite.T(), err)
+		assert.Equal(suite.T(), expected, *item)
+	}
+
+	item, err := suite.stack.PeekAt(3)
+	assert.Nil(suite.T(), item)
+	require.ErrorIs(suite.T(), err, stack.ErrNotEnoughItems)
+
+	item, err = suite.stack.PeekAt(math.MaxInt)
+	assert.Nil(suite.T(), item)
+	require.ErrorIs(suite.T(), err, stack.ErrNotEnoughItems)
+
+	item, err = suite.stack.PeekAt(-1)
+	assert.Nil(suite.T(), item)
+	require.ErrorIs(suite.T(), err, stack.ErrInvalidCount)
+
+	assert.Equal(suite.T(), 3, suite.stack.Len())
+}
+
+func (suite *StackOpsTestSuite) TestSwap() {
+	suite.stack.PushAll(1, 2, 3)
+
+	require.NoError(suite.T(), suite.stack.Swap())
+	assert.Equal(suite.T(), []int{1, 3, 2}, suite.stack.ToSlice())
+}
+
+func (suite *StackOpsTestSuite) TestDup() {
+	suite.stack.PushAll(1, 2)
+
+	require.NoError(suite.T(), suite.stack.Dup())
+	assert.Equal(suite.T(), []int{1, 2, 2}, suite.stack.ToSlice())
+}
+
+func (suite *StackOpsTestSuite) TestOver() {
+	suite.stack.PushAll(1, 2)
+
+	require.NoError(suite.T(), suite.stack.Over())
+	assert.Equal(suite.T(), []int{1, 2, 1}, suite.stack.ToSlice())
+}
+
+func (suite *StackOpsTestSuite) TestRot() {
+	suite.stack.PushAll(0, 1, 2, 3)
+
+	require.NoError(suite.T(), suite.stack.Rot())
+	assert.Equal(suite.T(), []int{0, 2, 3, 1}, suite.stack.ToSlice())
+}
+
+func (suite *StackOpsTestSuite) TestNotEnoughItems() {
+	suite.stack.Push(1)
+
+	assert.ErrorIs(suite.T(), suite.stack.Swap(), stack.ErrNotEnoughItems)
+	assert.ErrorIs(suite.T(), suite.stack.Over(), stack.ErrNotEnoughItems)
+	assert.ErrorIs(suite.T(), suite.stack.Rot(), stack.ErrNotEnoughItems)
+
+	suite.stack.Clear()
+	assert.ErrorIs(suite.T(), suite.stack.Dup(), stack.ErrNotEnoughItems)
+}
+
+func (suite *StackOpsTestSuite) TestClear() {
+	suite.stack.PushAll(1, 2, 3)
+	suite.stack.Clear()
+
+	assert.True(suite.T(), suite.stack.IsEmpty())
+	assert.Empty(suite.T(), suite.stack.ToSlice())
+
+	suite.stack.Push(4)
+	assert.Equal(suite.T(), []int{4}, suite.stack.ToSlice())
+}
+
+func (suite *StackOpsTestSuite) TestToSliceIsACopy() {
+	suite.stack.PushAll(1, 2)
+
+	items := suite.stack.ToSlice()
+	items[0] = 9
+
+	assert.Equal(suite.T(), []int{1, 2}, suite.stack.ToSlice())
+}
+
+// TestEvaluateRPN uses the operations the way an expression evaluator would.
+func (suite *StackOpsTestSuite) TestEvaluateRPN() {
+	// ( 3 4 -- 3 4 3 ) then multiply, add: 3 + 4 * 3.
+	suite.stack.PushAll(3, 4)
+	require.NoError(suite.T(), suite.stack.Over())
+
+	operands, err := suite.stack.PopN(2)
+	require.NoError(suite.T(), err)
+	suite.stack.Push(operands[0] * operands[1])
+
+	operands, err = suite.stack.PopN(2)
+	require.NoError(suite.T(), err)
+	suite.stack.Push(operands[0] + operands[1])
+
+	assert.Equal(suite.T(), []int{15}, suite.stack.ToSlice())
+}
+
+func (suite *StackOpsTestSuite) TestConcurrentPushAllAndPopN() {
+	goroutines := 8
+	perGoroutine := 500
+
+	var wg sync.WaitGroup
+
+	popped := make([][]int, goroutines)
+
+	for g := range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			// Each goroutine pushes a value followed by its negation and pops two items. Since
+			// both happen at once, every popped pair must be a pushed pair, negation first.
+			for i := range perGoroutine {
+				value := g*perGoroutine + i + 1
+				suite.stack.PushAll(value, -value)
+
+				pair, err := suite.stack.PopN(2)
+				if err == nil {
+					popped[g] = append(popped[g], pair...)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	for _, pairs := range popped {
+		for pair := range slices.Chunk(pairs, 2) {
+			assert.Equal(suite.T(), -pair[0], pair[1])
+		}
+	}
+
+	for pair := range slices.Chunk(suite.stack.ToSlice(), 2) {
+		assert.Equal(suite.T(), -pair[0], pair[1])
+	}
+}
@@ -2,6 +2,7 @@
 package stack
 
 import (
+	"iter"
 	"sync"
 )
 
@@ -16,6 +17,18 @@ func New[T comparable]() *Stack[T] {
 	return &Stack[T]{}
 }
 
+// Collect creates a new stack by pushing the values produced by seq in order,
+// so the last value ends up on top.
+func Collect[T comparable](seq iter.Seq[T]) *Stack[T] {
+	newStack := New[T]()
+
+	for value := range seq {
+		newStack.items = append(newStack.items, value)
+	}
+
+	return newStack
+}
+
 // Push adds an item to the top of the stack.
 func (s *Stack[T]) Push(value T) {
 	s.mu.Lock()
@@ -72,3 +85,27 @@ func (s *Stack[T]) Len() int {
 
 	return len(s.items)
 }
+
+// All returns an iterator over the items in the stack from the bottom to the top.
+// The items are copied under the lock when iteration starts, so the stack may be
+// modified while iterating.
+func (s *Stack[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.snapshot() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// snapshot returns a copy of the items in the stack from the bottom to the top.
+func (s *Stack[T]) snapshot() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	items := make([]T, len(s.items))
+	copy(items, s.items)
+
+	return items
+}
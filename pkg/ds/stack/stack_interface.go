@@ -3,9 +3,18 @@ package stack
 
 import "errors"
 
-// ErrEmptyStack is an error indicating the stack is empty when attempting to
-// peek at or remove the element on the top of the stack.
-var ErrEmptyStack = errors.New("empty stack")
+var (
+	// ErrEmptyStack is an error indicating the stack is empty when attempting to
+	// peek at or remove the element on the top of the stack.
+	ErrEmptyStack = errors.New("empty stack")
+
+	// ErrNotEnoughItems is an error indicating the stack holds fewer items than an
+	// operation needs.
+	ErrNotEnoughItems = errors.New("not enough items")
+
+	// ErrInvalidCount is an error indicating a negative item count or depth.
+	ErrInvalidCount = errors.New("invalid count")
+)
 
 // Stacker defines the operations a stack should implement.
 type Stacker[T comparable] interface {
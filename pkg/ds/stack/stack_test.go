@@ -1,6 +1,8 @@
 package stack_test
 
 import (
+	"iter"
+	"slices"
 	"testing"
 
 	"github.com/dqfan2012/playground/pkg/ds/stack"
@@ -8,20 +10,45 @@ import (
 	"github.com/stretchr/testify/suite"
 )
 
+// iterableStack is a stack whose items can be iterated from the bottom to the top.
+type iterableStack[T comparable] interface {
+	stack.Stacker[T]
+	All() iter.Seq[T]
+}
+
+// StackTestSuite checks the behavior every stack implementation must share.
 type StackTestSuite struct {
 	suite.Suite
+	newStack       func() iterableStack[int]
+	newStringStack func() iterableStack[string]
+	newFloatStack  func() iterableStack[float64]
+	collect        func(iter.Seq[int]) iterableStack[int]
 	// Demonstrate that the Stack works with multiple data types
-	stack       *stack.Stack[int]
-	stringStack *stack.Stack[string]
-	floatStack  *stack.Stack[float64]
+	stack       iterableStack[int]
+	stringStack iterableStack[string]
+	floatStack  iterableStack[float64]
 }
 
 func TestStackTestSuite(t *testing.T) {
-	suite.Run(t, new(StackTestSuite))
+	suite.Run(t, &StackTestSuite{
+		newStack:       func() iterableStack[int] { return stack.New[int]() },
+		newStringStack: func() iterableStack[string] { return stack.New[string]() },
+		newFloatStack:  func() iterableStack[float64] { return stack.New[float64]() },
+		collect:        func(seq iter.Seq[int]) iterableStack[int] { return stack.Collect(seq) },
+	})
+}
+
+func TestLockFreeStackTestSuite(t *testing.T) {
+	suite.Run(t, &StackTestSuite{
+		newStack:       func() iterableStack[int] { return stack.NewLockFree[int]() },
+		newStringStack: func() iterableStack[string] { return stack.NewLockFree[string]() },
+		newFloatStack:  func() iterableStack[float64] { return stack.NewLockFree[float64]() },
+		collect:        func(seq iter.Seq[int]) iterableStack[int] { return stack.CollectLockFree(seq) },
+	})
 }
 
 func (suite *StackTestSuite) TestNew() {
-	suite.stack = stack.New[int]()
+	suite.stack = suite.newStack()
 	expectedLength := 3
 
 	suite.stack.Push(3)
@@ -33,7 +60,7 @@ func (suite *StackTestSuite) TestNew() {
 }
 
 func (suite *StackTestSuite) TestNewStringStack() {
-	suite.stringStack = stack.New[string]()
+	suite.stringStack = suite.newStringStack()
 	expectedLength := 3
 
 	suite.stringStack.Push("Hello,")
@@ -45,7 +72,7 @@ func (suite *StackTestSuite) TestNewStringStack() {
 }
 
 func (suite *StackTestSuite) TestNewFloatStack() {
-	suite.floatStack = stack.New[float64]()
+	suite.floatStack = suite.newFloatStack()
 	expectedLength := 3
 
 	suite.floatStack.Push(5.5)
@@ -57,7 +84,7 @@ func (suite *StackTestSuite) TestNewFloatStack() {
 }
 
 func (suite *StackTestSuite) TestPop() {
-	suite.stack = stack.New[int]()
+	suite.stack = suite.newStack()
 
 	suite.stack.Push(3)
 	suite.stack.Push(5)
@@ -73,7 +100,7 @@ func (suite *StackTestSuite) TestPop() {
 }
 
 func (suite *StackTestSuite) TestPopError() {
-	suite.stack = stack.New[int]()
+	suite.stack = suite.newStack()
 
 	_, err := suite.stack.Pop()
 
@@ -82,7 +109,7 @@ func (suite *StackTestSuite) TestPopError() {
 }
 
 func (suite *StackTestSuite) TestPeek() {
-	suite.stack = stack.New[int]()
+	suite.stack = suite.newStack()
 
 	suite.stack.Push(3)
 	suite.stack.Push(5)
@@ -94,7 +121,7 @@ func (suite *StackTestSuite) TestPeek() {
 }
 
 func (suite *StackTestSuite) TestPeekError() {
-	suite.stack = stack.New[int]()
+	suite.stack = suite.newStack()
 
 	_, err := suite.stack.Peek()
 
@@ -103,12 +130,12 @@ func (suite *StackTestSuite) TestPeekError() {
 }
 
 func (suite *StackTestSuite) TestEmptyStackIsEmpty() {
-	suite.stack = stack.New[int]()
+	suite.stack = suite.newStack()
 	assert.True(suite.T(), suite.stack.IsEmpty())
 }
 
 func (suite *StackTestSuite) TestNotEmptyStackIsEmpty() {
-	suite.stack = stack.New[int]()
+	suite.stack = suite.newStack()
 
 	suite.stack.Push(3)
 	suite.stack.Push(5)
@@ -116,3 +143,21 @@ func (suite *StackTestSuite) TestNotEmptyStackIsEmpty() {
 
 	assert.False(suite.T(), suite.stack.IsEmpty())
 }
+
+func (suite *StackTestSuite) TestAll() {
+	suite.stack = suite.newStack()
+
+	suite.stack.Push(3)
+	suite.stack.Push(5)
+	suite.stack.Push(9)
+
+	assert.Equal(suite.T(), []int{3, 5, 9}, slices.Collect(suite.stack.All()))
+}
+
+func (suite *StackTestSuite) TestCollect() {
+	suite.stack = suite.collect(slices.Values([]int{3, 5, 9}))
+	top, _ := suite.stack.Peek()
+
+	assert.Equal(suite.T(), 3, suite.stack.Len())
+	assert.Equal(suite.T(), 9, *top)
+}
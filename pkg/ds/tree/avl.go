@@ -0,0 +1,300 @@
+// Package tree implements balanced binary search trees.
+package tree
+
+import (
+	"cmp"
+	"sync"
+
+	"github.com/dqfan2012/playground/pkg/ds/internal/avl"
+)
+
+// AVL satisfies the ordered map, ordered query and traversal interfaces.
+var (
+	_ OrderedMapper[int, int]  = (*AVL[int, int])(nil)
+	_ OrderedQuerier[int, int] = (*AVL[int, int])(nil)
+	_ Traverser[int, int]      = (*AVL[int, int])(nil)
+)
+
+// AVL represents an AVL tree: a binary search tree whose subtrees' heights differ by at most
+// one at every node, which keeps its height below 1.44 log2(n+2) and every operation O(log n).
+// Mutex ensures the implementation of AVL is thread-safe.
+type AVL[K, V any] struct {
+	root    *node[K, V]
+	compare func(a, b K) int
+	len     int
+	mu      sync.Mutex
+}
+
+// node is a key and value with its subtrees. height is the number of nodes on the longest
+// path down to a leaf, so a leaf's height is 1 and an empty subtree's is 0.
+type node[K, V any] struct {
+	key         K
+	value       V
+	left, right *node[K, V]
+	height      int
+}
+
+// New creates a new AVL tree ordering its keys by cmp.Compare.
+func New[K cmp.Ordered, V any]() *AVL[K, V] {
+	return NewWithComparator[K, V](cmp.Compare[K])
+}
+
+// NewWithComparator creates a new AVL tree ordering its keys by compare, which returns a
+// negative number, zero or a positive number when a is less than, equal to or greater than b.
+func NewWithComparator[K, V any](compare func(a, b K) int) *AVL[K, V] {
+	return &AVL[K, V]{compare: compare}
+}
+
+// Insert sets the value for the key. It returns true if the key is new and false if an
+// existing value was replaced.
+func (t *AVL[K, V]) Insert(key K, value V) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var inserted bool
+
+	t.root, inserted = t.insert(t.root, key, value)
+	if inserted {
+		t.len++
+	}
+
+	return inserted
+}
+
+// Get returns the value for the key and true, or the zero value and false if the key
+// isn't present.
+func (t *AVL[K, V]) Get(key K) (V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current := t.root
+
+	for current != nil {
+		switch order := t.compare(key, current.key); {
+		case order < 0:
+			current = current.left
+		case order > 0:
+			current = current.right
+		default:
+			return current.value, true
+		}
+	}
+
+	var zero V
+
+	return zero, false
+}
+
+// Delete removes the key. It returns false if the key isn't present.
+func (t *AVL[K, V]) Delete(key K) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var deleted bool
+
+	t.root, deleted = t.delete(t.root, key)
+	if deleted {
+		t.len--
+	}
+
+	return deleted
+}
+
+// Min returns the smallest key and its value, or false if the tree is empty.
+func (t *AVL[K, V]) Min() (K, V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current := t.root
+	for current != nil && current.left != nil {
+		current = current.left
+	}
+
+	return result(current)
+}
+
+// Max returns the largest key and its value, or false if the tree is empty.
+func (t *AVL[K, V]) Max() (K, V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	current := t.root
+	for current != nil && current.right != nil {
+		current = current.right
+	}
+
+	return result(current)
+}
+
+// Floor returns the largest key not greater than the key and its value, or false if every
+// key is greater.
+func (t *AVL[K, V]) Floor(key K) (K, V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var floor *node[K, V]
+
+	for current := t.root; current != nil; {
+		switch order := t.compare(key, current.key); {
+		case order < 0:
+			current = current.left
+		case order > 0:
+			floor = current
+			current = current.right
+		default:
+			return result(current)
+		}
+	}
+
+	return result(floor)
+}
+
+// Ceiling returns the smallest key not less than the key and its value, or false if every
+// key is less.
+func (t *AVL[K, V]) Ceiling(key K) (K, V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return result(t.ceiling(key))
+}
+
+// Clear removes every key.
+func (t *AVL[K, V]) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.root = nil
+	t.len = 0
+}
+
+// Height returns the number of nodes on the longest path from the root to a leaf.
+func (t *AVL[K, V]) Height() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.root.Height()
+}
+
+// IsEmpty returns true if the tree holds no keys.
+func (t *AVL[K, V]) IsEmpty() bool {
+	return t.Len() == 0
+}
+
+// Len returns the number of keys.
+func (t *AVL[K, V]) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.len
+}
+
+// insert sets the value for the key in the subtree and returns the subtree's new root and
+// whether the key is new.
+func (t *AVL[K, V]) insert(root *node[K, V], key K, value V) (*node[K, V], bool) {
+	if root == nil {
+		return &node[K, V]{key: key, value: value, height: 1}, true
+	}
+
+	var inserted bool
+
+	switch order := t.compare(key, root.key); {
+	case order < 0:
+		root.left, inserted = t.insert(root.left, key, value)
+	case order > 0:
+		root.right, inserted = t.insert(root.right, key, value)
+	default:
+		root.value = value
+
+		return root, false
+	}
+
+	return avl.Rebalance(root), inserted
+}
+
+// delete removes the key from the subtree and returns the subtree's new root and whether
+// the key was present.
+func (t *AVL[K, V]) delete(root *node[K, V], key K) (*node[K, V], bool) {
+	if root == nil {
+		return nil, false
+	}
+
+	var deleted bool
+
+	switch order := t.compare(key, root.key); {
+	case order < 0:
+		root.left, deleted = t.delete(root.left, key)
+	case order > 0:
+		root.right, deleted = t.delete(root.right, key)
+	default:
+		return avl.RemoveRoot(root), true
+	}
+
+	return avl.Rebalance(root), deleted
+}
+
+// ceiling returns the node with the smallest key not less than the key, or nil if there is none.
+func (t *AVL[K, V]) ceiling(key K) *node[K, V] {
+	var ceiling *node[K, V]
+
+	for current := t.root; current != nil; {
+		switch order := t.compare(key, current.key); {
+		case order < 0:
+			ceiling = current
+			current = current.left
+		case order > 0:
+			current = current.right
+		default:
+			return current
+		}
+	}
+
+	return ceiling
+}
+
+// Left returns the node's left child.
+func (n *node[K, V]) Left() *node[K, V] {
+	return n.left
+}
+
+// Right returns the node's right child.
+func (n *node[K, V]) Right() *node[K, V] {
+	return n.right
+}
+
+// SetLeft replaces the node's left child.
+func (n *node[K, V]) SetLeft(left *node[K, V]) {
+	n.left = left
+}
+
+// SetRight replaces the node's right child.
+func (n *node[K, V]) SetRight(right *node[K, V]) {
+	n.right = right
+}
+
+// Height returns the node's height, which is 0 for an empty subtree.
+func (n *node[K, V]) Height() int {
+	if n == nil {
+		return 0
+	}
+
+	return n.height
+}
+
+// Update recomputes the node's height from its children's.
+func (n *node[K, V]) Update() {
+	n.height = 1 + max(n.left.Height(), n.right.Height())
+}
+
+// result returns the node's key and value and true, or zero values and false if it is nil.
+func result[K, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var (
+			zeroKey   K
+			zeroValue V
+		)
+
+		return zeroKey, zeroValue, false
+	}
+
+	return n.key, n.value, true
+}
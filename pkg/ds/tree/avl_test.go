@@ -0,0 +1,283 @@
+package tree_test
+
+import (
+	"maps"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/tree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type AVLTestSuite struct {
+	suite.Suite
+	tree *tree.AVL[int, string]
+}
+
+func TestAVLTestSuite(t *testing.T) {
+	suite.Run(t, new(AVLTestSuite))
+}
+
+func (suite *AVLTestSuite) SetupTest() {
+	suite.tree = tree.New[int, string]()
+}
+
+func (suite *AVLTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.tree.CheckInvariants())
+}
+
+// fill inserts the keys, each with its name as the value.
+func (suite *AVLTestSuite) fill(keys ...int) {
+	for _, key := range keys {
+		suite.tree.Insert(key, name(key))
+	}
+}
+
+// name returns a value for the key.
+func name(key int) string {
+	return string(rune('a' + key%26))
+}
+
+// keys collects the keys produced by an iterator.
+func keys[K, V any](seq func(yield func(K, V) bool)) []K {
+	var collected []K
+
+	for key := range seq {
+		collected = append(collected, key)
+	}
+
+	return collected
+}
+
+// maxHeight returns the greatest height an AVL tree with n nodes can have.
+func maxHeight(n int) int {
+	return int(1.4405 * math.Log2(float64(n)+2))
+}
+
+func (suite *AVLTestSuite) TestNew() {
+	assert.True(suite.T(), suite.tree.IsEmpty())
+	assert.Equal(suite.T(), 0, suite.tree.Len())
+	assert.Equal(suite.T(), 0, suite.tree.Height())
+}
+
+func (suite *AVLTestSuite) TestInsertAndGet() {
+	assert.True(suite.T(), suite.tree.Insert(2, "two"))
+	assert.True(suite.T(), suite.tree.Insert(1, "one"))
+
+	value, found := suite.tree.Get(2)
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), "two", value)
+
+	value, found = suite.tree.Get(3)
+	assert.False(suite.T(), found)
+	assert.Zero(suite.T(), value)
+	assert.Equal(suite.T(), 2, suite.tree.Len())
+}
+
+func (suite *AVLTestSuite) TestInsertReplaces() {
+	suite.tree.Insert(1, "one")
+
+	assert.False(suite.T(), suite.tree.Insert(1, "uno"))
+
+	value, _ := suite.tree.Get(1)
+	assert.Equal(suite.T(), "uno", value)
+	assert.Equal(suite.T(), 1, suite.tree.Len())
+}
+
+func (suite *AVLTestSuite) TestDelete() {
+	suite.fill(1, 2, 3, 4, 5)
+
+	assert.True(suite.T(), suite.tree.Delete(2))
+	assert.True(suite.T(), suite.tree.Delete(4))
+	assert.False(suite.T(), suite.tree.Delete(2))
+	assert.False(suite.T(), suite.tree.Delete(6))
+
+	_, found := suite.tree.Get(2)
+	assert.False(suite.T(), found)
+	assert.Equal(suite.T(), []int{1, 3, 5}, keys(suite.tree.All()))
+	assert.Equal(suite.T(), 3, suite.tree.Len())
+}
+
+func (suite *AVLTestSuite) TestDeleteRoot() {
+	suite.fill(1, 2, 3)
+
+	assert.True(suite.T(), suite.tree.Delete(2))
+	assert.Equal(suite.T(), []int{1, 3}, keys(suite.tree.All()))
+}
+
+func (suite *AVLTestSuite) TestMinAndMax() {
+	_, _, found := suite.tree.Min()
+	assert.False(suite.T(), found)
+
+	_, _, found = suite.tree.Max()
+	assert.False(suite.T(), found)
+
+	suite.fill(5, 3, 9, 1, 7)
+
+	key, value, found := suite.tree.Min()
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), 1, key)
+	assert.Equal(suite.T(), name(1), value)
+
+	key, value, found = suite.tree.Max()
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), 9, key)
+	assert.Equal(suite.T(), name(9), value)
+}
+
+func (suite *AVLTestSuite) TestFloorAndCeiling() {
+	suite.fill(10, 20, 30)
+
+	tests := []struct {
+		key                  int
+		floor, ceiling       int
+		hasFloor, hasCeiling bool
+	}{
+		{key: 5, ceiling: 10, hasCeiling: true},
+		{key: 10, floor: 10, ceiling: 10, hasFloor: true, hasCeiling: true},
+		{key: 15, floor: 10, ceiling: 20, hasFloor: true, hasCeiling: true},
+		{key: 30, floor: 30, ceiling: 30, hasFloor: true, hasCeiling: true},
+		{key: 35, floor: 30, hasFloor: true},
+	}
+
+	for _, test := range tests {
+		floor, _, found := suite.tree.Floor(test.key)
+		assert.Equal(suite.T(), test.hasFloor, found, "floor of %d", test.key)
+		assert.Equal(suite.T(), test.floor, floor, "floor of %d", test.key)
+
+		ceiling, _, found := suite.tree.Ceiling(test.key)
+		assert.Equal(suite.T(), test.hasCeiling, found, "ceiling of %d", test.key)
+		assert.Equal(suite.T(), test.ceiling, ceiling, "ceiling of %d", test.key)
+	}
+}
+
+func (suite *AVLTestSuite) TestRange() {
+	suite.fill(1, 3, 5, 7, 9)
+
+	assert.Equal(suite.T(), []int{3, 5, 7}, keys(suite.tree.Range(2, 9)))
+	assert.Equal(suite.T(), []int{3, 5}, keys(suite.tree.Range(3, 7)))
+	assert.Equal(suite.T(), []int{1, 3, 5, 7, 9}, keys(suite.tree.Range(0, 10)))
+	assert.Empty(suite.T(), keys(suite.tree.Range(4, 5)))
+	assert.Empty(suite.T(), keys(suite.tree.Range(7, 3)))
+}
+
+func (suite *AVLTestSuite) TestClear() {
+	suite.fill(1, 2, 3)
+
+	suite.tree.Clear()
+
+	assert.True(suite.T(), suite.tree.IsEmpty())
+	assert.Empty(suite.T(), keys(suite.tree.All()))
+}
+
+func (suite *AVLTestSuite) TestSortedInsertsStayBalanced() {
+	const n = 1 << 12
+
+	for key := range n {
+		suite.tree.Insert(key, name(key))
+	}
+
+	// A plain binary search tree would degrade into a list of height n.
+	assert.LessOrEqual(suite.T(), suite.tree.Height(), maxHeight(n))
+
+	for key := range n / 2 {
+		suite.tree.Delete(key)
+	}
+
+	assert.LessOrEqual(suite.T(), suite.tree.Height(), maxHeight(n/2))
+}
+
+func (suite *AVLTestSuite) TestComparator() {
+	// Keys that differ only in case are the same key.
+	byName := tree.NewWithComparator[string, int](func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+
+	byName.Insert("banana", 1)
+	byName.Insert("Apple", 2)
+	byName.Insert("cherry", 3)
+
+	assert.False(suite.T(), byName.Insert("APPLE", 4))
+	assert.Equal(suite.T(), []string{"Apple", "banana", "cherry"}, keys(byName.All()))
+
+	value, found := byName.Get("apple")
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), 4, value)
+	assert.NoError(suite.T(), byName.CheckInvariants())
+}
+
+func (suite *AVLTestSuite) TestAgainstModel() {
+	rng := rand.New(rand.NewPCG(3, 4))
+	model := map[int]string{}
+
+	for step := range 5000 {
+		key := rng.IntN(200)
+
+		switch rng.IntN(4) {
+		case 0, 1:
+			value := name(rng.IntN(26))
+			_, existed := model[key]
+			model[key] = value
+
+			assert.Equal(suite.T(), !existed, suite.tree.Insert(key, value))
+		case 2:
+			_, existed := model[key]
+			delete(model, key)
+
+			assert.Equal(suite.T(), existed, suite.tree.Delete(key))
+		default:
+			value, found := suite.tree.Get(key)
+			expected, existed := model[key]
+
+			assert.Equal(suite.T(), existed, found)
+			assert.Equal(suite.T(), expected, value)
+		}
+
+		require.NoError(suite.T(), suite.tree.CheckInvariants(), "step %d", step)
+	}
+
+	sorted := slices.Sorted(maps.Keys(model))
+
+	assert.Equal(suite.T(), sorted, keys(suite.tree.All()))
+	assert.Equal(suite.T(), len(model), suite.tree.Len())
+
+	for key := -1; key <= 200; key++ {
+		index, _ := slices.BinarySearch(sorted, key)
+
+		ceiling, _, found := suite.tree.Ceiling(key)
+		assert.Equal(suite.T(), index < len(sorted), found)
+
+		if found {
+			assert.Equal(suite.T(), sorted[index], ceiling)
+		}
+	}
+}
+
+func (suite *AVLTestSuite) TestConcurrentInserts() {
+	var wg sync.WaitGroup
+
+	for worker := range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range 100 {
+				key := worker*100 + i
+				suite.tree.Insert(key, name(key))
+				suite.tree.Get(key)
+				_, _, _ = suite.tree.Floor(key)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(suite.T(), 800, suite.tree.Len())
+}
@@ -0,0 +1,64 @@
+package tree
+
+import (
+	"errors"
+	"fmt"
+)
+
+// errInvariant is an error indicating a tree's internal bookkeeping is inconsistent.
+var errInvariant = errors.New("invariant violated")
+
+// CheckInvariants exposes checkInvariants to the tree_test package.
+func (t *AVL[K, V]) CheckInvariants() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.checkInvariants()
+}
+
+// checkInvariants verifies the keys are sorted in order, every node's height is one more than
+// its taller child's, no node's subtrees differ in height by more than one and the tree holds
+// len nodes.
+func (t *AVL[K, V]) checkInvariants() error {
+	var (
+		prev  *node[K, V]
+		count int
+		err   error
+	)
+
+	inOrder(t.root, func(n *node[K, V]) {
+		if err != nil {
+			return
+		}
+
+		count++
+
+		if prev != nil && t.compare(prev.key, n.key) >= 0 {
+			err = fmt.Errorf("%w: key %v follows %v", errInvariant, n.key, prev.key)
+
+			return
+		}
+
+		prev = n
+
+		if expected := 1 + max(n.left.Height(), n.right.Height()); n.height != expected {
+			err = fmt.Errorf("%w: node %v has height %d, expected %d", errInvariant, n.key, n.height, expected)
+
+			return
+		}
+
+		if balance := n.left.Height() - n.right.Height(); balance < -1 || balance > 1 {
+			err = fmt.Errorf("%w: node %v has balance factor %d", errInvariant, n.key, balance)
+		}
+	})
+
+	if err != nil {
+		return err
+	}
+
+	if count != t.len {
+		return fmt.Errorf("%w: %d nodes, len is %d", errInvariant, count, t.len)
+	}
+
+	return nil
+}
@@ -0,0 +1,160 @@
+// Package tree implements balanced binary search trees.
+package tree
+
+import "iter"
+
+// All returns an iterator over the keys and values in sorted order.
+// The keys are read under the lock when iteration starts, so the tree may be modified
+// while iterating.
+func (t *AVL[K, V]) All() iter.Seq2[K, V] {
+	return t.InOrder()
+}
+
+// Range returns an iterator over the keys in [from, to) and their values in sorted order.
+// The keys are read under the lock when iteration starts, so the tree may be modified
+// while iterating.
+func (t *AVL[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return t.snapshot(func(visit func(*node[K, V])) {
+		t.inRange(t.root, from, to, visit)
+	})
+}
+
+// InOrder returns an iterator over the keys and values in sorted order: each node's left
+// subtree, then the node, then its right subtree. The keys are read under the lock when
+// iteration starts, so the tree may be modified while iterating.
+func (t *AVL[K, V]) InOrder() iter.Seq2[K, V] {
+	return t.snapshot(func(visit func(*node[K, V])) {
+		inOrder(t.root, visit)
+	})
+}
+
+// PreOrder returns an iterator over the keys and values visiting each node before its
+// subtrees, which is the order that rebuilds the same tree when inserted into an empty one.
+// The keys are read under the lock when iteration starts, so the tree may be modified
+// while iterating.
+func (t *AVL[K, V]) PreOrder() iter.Seq2[K, V] {
+	return t.snapshot(func(visit func(*node[K, V])) {
+		preOrder(t.root, visit)
+	})
+}
+
+// PostOrder returns an iterator over the keys and values visiting each node after its
+// subtrees. The keys are read under the lock when iteration starts, so the tree may be
+// modified while iterating.
+func (t *AVL[K, V]) PostOrder() iter.Seq2[K, V] {
+	return t.snapshot(func(visit func(*node[K, V])) {
+		postOrder(t.root, visit)
+	})
+}
+
+// LevelOrder returns an iterator over the keys and values level by level from the root,
+// left to right within a level. The keys are read under the lock when iteration starts, so
+// the tree may be modified while iterating.
+func (t *AVL[K, V]) LevelOrder() iter.Seq2[K, V] {
+	return t.snapshot(func(visit func(*node[K, V])) {
+		if t.root == nil {
+			return
+		}
+
+		level := []*node[K, V]{t.root}
+
+		for len(level) > 0 {
+			var next []*node[K, V]
+
+			for _, current := range level {
+				visit(current)
+
+				for _, child := range []*node[K, V]{current.left, current.right} {
+					if child != nil {
+						next = append(next, child)
+					}
+				}
+			}
+
+			level = next
+		}
+	})
+}
+
+// entry is a copy of a node's key and value.
+type entry[K, V any] struct {
+	key   K
+	value V
+}
+
+// snapshot returns an iterator over copies of the nodes that walk visits, taken under the
+// lock when iteration starts.
+func (t *AVL[K, V]) snapshot(walk func(visit func(*node[K, V]))) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.mu.Lock()
+
+		entries := make([]entry[K, V], 0, t.len)
+		walk(func(n *node[K, V]) {
+			entries = append(entries, entry[K, V]{key: n.key, value: n.value})
+		})
+
+		t.mu.Unlock()
+
+		for _, e := range entries {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// inRange visits the nodes of the subtree with keys in [from, to) in sorted order, skipping
+// the subtrees that can't hold any.
+func (t *AVL[K, V]) inRange(root *node[K, V], from, to K, visit func(*node[K, V])) {
+	if root == nil {
+		return
+	}
+
+	afterFrom := t.compare(root.key, from) >= 0
+	beforeTo := t.compare(root.key, to) < 0
+
+	if afterFrom {
+		t.inRange(root.left, from, to, visit)
+	}
+
+	if afterFrom && beforeTo {
+		visit(root)
+	}
+
+	if beforeTo {
+		t.inRange(root.right, from, to, visit)
+	}
+}
+
+// inOrder visits the subtree's nodes in sorted order.
+func inOrder[K, V any](root *node[K, V], visit func(*node[K, V])) {
+	if root == nil {
+		return
+	}
+
+	inOrder(root.left, visit)
+	visit(root)
+	inOrder(root.right, visit)
+}
+
+// preOrder visits each of the subtree's nodes before its children.
+func preOrder[K, V any](root *node[K, V], visit func(*node[K, V])) {
+	if root == nil {
+		return
+	}
+
+	visit(root)
+	preOrder(root.left, visit)
+	preOrder(root.right, visit)
+}
+
+// postOrder visits each of the subtree's nodes after its children.
+func postOrder[K, V any](root *node[K, V], visit func(*node[K, V])) {
+	if root == nil {
+		return
+	}
+
+	postOrder(root.left, visit)
+	postOrder(root.right, visit)
+	visit(root)
+}
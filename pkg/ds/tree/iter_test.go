@@ -0,0 +1,95 @@
+package tree_test
+
+import (
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/tree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type IterTestSuite struct {
+	suite.Suite
+	tree *tree.AVL[int, string]
+}
+
+func TestIterTestSuite(t *testing.T) {
+	suite.Run(t, new(IterTestSuite))
+}
+
+// SetupTest inserts 1 to 7 in order, which rotates the tree into the perfect tree
+//
+//	   4
+//	 2   6
+//	1 3 5 7
+func (suite *IterTestSuite) SetupTest() {
+	suite.tree = tree.New[int, string]()
+
+	for key := 1; key <= 7; key++ {
+		suite.tree.Insert(key, name(key))
+	}
+}
+
+func (suite *IterTestSuite) TestInOrder() {
+	assert.Equal(suite.T(), []int{1, 2, 3, 4, 5, 6, 7}, keys(suite.tree.InOrder()))
+	assert.Equal(suite.T(), keys(suite.tree.InOrder()), keys(suite.tree.All()))
+}
+
+func (suite *IterTestSuite) TestPreOrder() {
+	assert.Equal(suite.T(), []int{4, 2, 1, 3, 6, 5, 7}, keys(suite.tree.PreOrder()))
+}
+
+func (suite *IterTestSuite) TestPostOrder() {
+	assert.Equal(suite.T(), []int{1, 3, 2, 5, 7, 6, 4}, keys(suite.tree.PostOrder()))
+}
+
+func (suite *IterTestSuite) TestLevelOrder() {
+	assert.Equal(suite.T(), []int{4, 2, 6, 1, 3, 5, 7}, keys(suite.tree.LevelOrder()))
+}
+
+func (suite *IterTestSuite) TestValues() {
+	for key, value := range suite.tree.PreOrder() {
+		assert.Equal(suite.T(), name(key), value)
+	}
+}
+
+func (suite *IterTestSuite) TestPreOrderRebuildsTheSameTree() {
+	rebuilt := tree.New[int, string]()
+
+	for key, value := range suite.tree.PreOrder() {
+		rebuilt.Insert(key, value)
+	}
+
+	assert.Equal(suite.T(), keys(suite.tree.LevelOrder()), keys(rebuilt.LevelOrder()))
+}
+
+func (suite *IterTestSuite) TestStopsEarly() {
+	var visited []int
+
+	for key := range suite.tree.LevelOrder() {
+		visited = append(visited, key)
+		if key == 6 {
+			break
+		}
+	}
+
+	assert.Equal(suite.T(), []int{4, 2, 6}, visited)
+}
+
+func (suite *IterTestSuite) TestModifyWhileIterating() {
+	for key := range suite.tree.InOrder() {
+		suite.tree.Delete(key)
+	}
+
+	assert.True(suite.T(), suite.tree.IsEmpty())
+	assert.NoError(suite.T(), suite.tree.CheckInvariants())
+}
+
+func (suite *IterTestSuite) TestEmpty() {
+	empty := tree.New[int, string]()
+
+	assert.Empty(suite.T(), keys(empty.InOrder()))
+	assert.Empty(suite.T(), keys(empty.PreOrder()))
+	assert.Empty(suite.T(), keys(empty.PostOrder()))
+	assert.Empty(suite.T(), keys(empty.LevelOrder()))
+}
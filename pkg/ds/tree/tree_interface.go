@@ -0,0 +1,33 @@
+// Package tree implements balanced binary search trees.
+package tree
+
+import "iter"
+
+// OrderedMapper defines the operations for a map that keeps its keys sorted.
+type OrderedMapper[K, V any] interface {
+	Insert(key K, value V) bool
+	Get(key K) (V, bool)
+	Delete(key K) bool
+	All() iter.Seq2[K, V]
+	Clear()
+	IsEmpty() bool
+	Len() int
+}
+
+// OrderedQuerier defines the queries that rely on the keys being sorted: the smallest and
+// largest keys, the nearest keys to a given one and the keys in a range.
+type OrderedQuerier[K, V any] interface {
+	Min() (K, V, bool)
+	Max() (K, V, bool)
+	Floor(key K) (K, V, bool)
+	Ceiling(key K) (K, V, bool)
+	Range(from, to K) iter.Seq2[K, V]
+}
+
+// Traverser defines the depth-first and breadth-first traversals of a tree.
+type Traverser[K, V any] interface {
+	InOrder() iter.Seq2[K, V]
+	PreOrder() iter.Seq2[K, V]
+	PostOrder() iter.Seq2[K, V]
+	LevelOrder() iter.Seq2[K, V]
+}
@@ -0,0 +1,81 @@
+package trie
+
+import (
+	"errors"
+	"fmt"
+)
+
+// errInvariant is an error indicating a trie's internal bookkeeping is inconsistent.
+var errInvariant = errors.New("invariant violated")
+
+// CheckInvariants exposes checkInvariants to the trie_test package.
+func (t *Trie[V]) CheckInvariants() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.checkInvariants()
+}
+
+// checkInvariants verifies every label below the root is non-empty, children are sorted by
+// distinct first bytes, every node below the root without a key has at least two children,
+// counts are positive exactly for keys, maxCount is the largest count in each subtree and
+// the trie holds len keys.
+func (t *Trie[V]) checkInvariants() error {
+	if t.root.label != "" {
+		return fmt.Errorf("%w: the root has label %q", errInvariant, t.root.label)
+	}
+
+	keys := 0
+
+	var check func(n *node[V], path string) error
+
+	check = func(n *node[V], path string) error {
+		if n != t.root {
+			if n.label == "" {
+				return fmt.Errorf("%w: empty label below %q", errInvariant, path)
+			}
+
+			if !n.terminal && len(n.children) < 2 {
+				return fmt.Errorf("%w: node %q holds no key and has %d children", errInvariant, path, len(n.children))
+			}
+		}
+
+		if n.terminal != (n.count > 0) {
+			return fmt.Errorf("%w: node %q has terminal %t and count %d", errInvariant, path, n.terminal, n.count)
+		}
+
+		if n.terminal {
+			keys++
+		}
+
+		maxCount := n.count
+
+		for i, child := range n.children {
+			if i > 0 && n.children[i-1].label[0] >= child.label[0] {
+				return fmt.Errorf("%w: children of %q aren't sorted", errInvariant, path)
+			}
+
+			if err := check(child, path+child.label); err != nil {
+				return err
+			}
+
+			maxCount = max(maxCount, child.maxCount)
+		}
+
+		if n.maxCount != maxCount {
+			return fmt.Errorf("%w: node %q has maxCount %d, expected %d", errInvariant, path, n.maxCount, maxCount)
+		}
+
+		return nil
+	}
+
+	if err := check(t.root, ""); err != nil {
+		return err
+	}
+
+	if keys != t.len {
+		return fmt.Errorf("%w: %d keys, len is %d", errInvariant, keys, t.len)
+	}
+
+	return nil
+}
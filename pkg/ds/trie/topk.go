@@ -0,0 +1,127 @@
+// Package trie implements the trie data structure.
+package trie
+
+import "fmt"
+
+// Match is a key returned by TopK, with its value and count.
+type Match[V any] struct {
+	Key   string
+	Value V
+	Count int
+}
+
+// TopK returns up to k keys that start with the prefix, with the highest counts first and
+// ties in sorted order, as an autocomplete would suggest them. Subtrees whose largest count
+// can't make the cut are never visited, so it is fast even when many keys match.
+func (t *Trie[V]) TopK(prefix string, k int) ([]Match[V], error) {
+	if k < 0 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidCount, k)
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	start, path := t.seek(prefix)
+	if start == nil || k == 0 {
+		return []Match[V]{}, nil
+	}
+
+	// Best-first search: a subtree is ranked by its largest count and its path, which no key
+	// in it beats, so a key popped from the queue beats everything still in it.
+	queue := &candidates[V]{{node: start, key: path}}
+	matches := make([]Match[V], 0, min(k, t.len))
+
+	for len(*queue) > 0 && len(matches) < k {
+		next := queue.pop()
+		n := next.node
+
+		if next.isKey {
+			matches = append(matches, Match[V]{Key: next.key, Value: n.value, Count: n.count})
+
+			continue
+		}
+
+		if n.terminal {
+			queue.push(candidate[V]{node: n, key: next.key, isKey: true})
+		}
+
+		for _, child := range n.children {
+			queue.push(candidate[V]{node: child, key: next.key + child.label})
+		}
+	}
+
+	return matches, nil
+}
+
+// candidate is either a key or a subtree waiting to be expanded in TopK.
+type candidate[V any] struct {
+	node  *node[V]
+	key   string
+	isKey bool
+}
+
+// rank returns the highest count the candidate can produce.
+func (c candidate[V]) rank() int {
+	if c.isKey {
+		return c.node.count
+	}
+
+	return c.node.maxCount
+}
+
+// candidates is a binary max-heap of candidates by rank, then by key in sorted order.
+type candidates[V any] []candidate[V]
+
+// before reports whether candidate i ranks before candidate j.
+func (c candidates[V]) before(i, j int) bool {
+	if c[i].rank() != c[j].rank() {
+		return c[i].rank() > c[j].rank()
+	}
+
+	return c[i].key < c[j].key
+}
+
+// push adds a candidate, sifting it up to its place.
+func (c *candidates[V]) push(next candidate[V]) {
+	*c = append(*c, next)
+
+	heap := *c
+	for i := len(heap) - 1; i > 0; {
+		parent := (i - 1) / 2
+		if !heap.before(i, parent) {
+			break
+		}
+
+		heap[i], heap[parent] = heap[parent], heap[i]
+		i = parent
+	}
+}
+
+// pop removes and returns the best candidate, sifting the last one down into its place.
+func (c *candidates[V]) pop() candidate[V] {
+	heap := *c
+	best := heap[0]
+	last := len(heap) - 1
+	heap[0] = heap[last]
+	heap = heap[:last]
+
+	for i := 0; ; {
+		first := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(heap) && heap.before(child, first) {
+				first = child
+			}
+		}
+
+		if first == i {
+			break
+		}
+
+		heap[i], heap[first] = heap[first], heap[i]
+		i = first
+	}
+
+	*c = heap
+
+	return best
+}
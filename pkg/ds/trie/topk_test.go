@@ -0,0 +1,106 @@
+package trie_test
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopK(t *testing.T) {
+	suggestions := trie.New[string]()
+
+	for query, times := range map[string]int{"golang": 5, "google": 9, "gopher": 5, "go": 2, "rust": 20} {
+		for range times {
+			suggestions.Insert(query, strings.ToUpper(query))
+		}
+	}
+
+	matches, err := suggestions.TopK("go", 3)
+	require.NoError(t, err)
+
+	// Ties are broken in sorted order.
+	assert.Equal(t, []trie.Match[string]{
+		{Key: "google", Value: "GOOGLE", Count: 9},
+		{Key: "golang", Value: "GOLANG", Count: 5},
+		{Key: "gopher", Value: "GOPHER", Count: 5},
+	}, matches)
+
+	matches, err = suggestions.TopK("go", 10)
+	require.NoError(t, err)
+	assert.Len(t, matches, 4)
+
+	matches, err = suggestions.TopK("java", 3)
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+
+	matches, err = suggestions.TopK("go", 0)
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+
+	_, err = suggestions.TopK("go", -1)
+	require.ErrorIs(t, err, trie.ErrInvalidCount)
+}
+
+func TestTopKAfterDelete(t *testing.T) {
+	suggestions := trie.New[int]()
+
+	for range 3 {
+		suggestions.Insert("tea", 0)
+	}
+
+	suggestions.Insert("ten", 0)
+	suggestions.Delete("tea")
+
+	matches, err := suggestions.TopK("te", 1)
+	require.NoError(t, err)
+	assert.Equal(t, []trie.Match[int]{{Key: "ten", Count: 1}}, matches)
+	assert.NoError(t, suggestions.CheckInvariants())
+}
+
+// TestTopKAgainstModel compares TopK with sorting every matching key by count.
+func TestTopKAgainstModel(t *testing.T) {
+	rng := rand.New(rand.NewPCG(10, 11))
+	suggestions := trie.New[int]()
+	counts := map[string]int{}
+
+	for range 3000 {
+		key := randomKey(rng)
+
+		if rng.IntN(10) == 0 {
+			suggestions.Delete(key)
+			delete(counts, key)
+		} else {
+			suggestions.Insert(key, 0)
+			counts[key]++
+		}
+	}
+
+	require.NoError(t, suggestions.CheckInvariants())
+
+	for range 200 {
+		prefix := randomKey(rng)
+		k := rng.IntN(8)
+
+		expected := []trie.Match[int]{}
+
+		for key, count := range counts {
+			if strings.HasPrefix(key, prefix) {
+				expected = append(expected, trie.Match[int]{Key: key, Count: count})
+			}
+		}
+
+		slices.SortFunc(expected, func(a, b trie.Match[int]) int {
+			return cmp.Or(cmp.Compare(b.Count, a.Count), strings.Compare(a.Key, b.Key))
+		})
+
+		matches, err := suggestions.TopK(prefix, k)
+		require.NoError(t, err)
+		assert.Equal(t, expected[:min(k, len(expected))], matches, "prefix %q k %d", prefix, k)
+	}
+}
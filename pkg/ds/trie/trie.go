@@ -0,0 +1,391 @@
+// Package trie implements the trie data structure.
+package trie
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Trie satisfies the prefix map interface.
+var _ PrefixMapper[int] = (*Trie[int])(nil)
+
+// Trie represents a compressed trie, also called a radix tree: a tree whose edges are
+// labeled with strings, where each key is the concatenation of the labels on the path to
+// its node. Chains of nodes with a single child and no key are merged into one edge, so the
+// tree has at most 2n nodes however long the keys are.
+//
+// Keys are compared byte by byte, which for UTF-8 is the same as comparing them rune by rune,
+// so keys in any script sort by code point and share prefixes the way their characters do.
+// Keys that aren't valid UTF-8 work too.
+// Mutex ensures the implementation of Trie is thread-safe.
+type Trie[V any] struct {
+	// root has an empty label and is never merged or removed.
+	root *node[V]
+	len  int
+	mu   sync.Mutex
+}
+
+// node is a point in the tree reached by following label from its parent. It holds a key
+// if terminal is set. Children are sorted by the first byte of their labels, which differ.
+type node[V any] struct {
+	label    string
+	children []*node[V]
+	value    V
+	terminal bool
+	// count is the number of times the node's key was inserted, or 0 if it holds no key.
+	count int
+	// maxCount is the largest count in the node's subtree, which lets TopK skip subtrees.
+	maxCount int
+}
+
+// New creates a new empty trie.
+func New[V any]() *Trie[V] {
+	return &Trie[V]{root: &node[V]{}}
+}
+
+// Insert sets the value for the key and adds one to the key's count, so inserting a key each
+// time it is used counts how often it is used. It returns true if the key is new.
+func (t *Trie[V]) Insert(key string, value V) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	path := []*node[V]{t.root}
+	current, rest := t.root, key
+
+	for rest != "" {
+		i, child := current.child(rest[0])
+		if child == nil {
+			child = &node[V]{label: rest}
+			current.children = slices.Insert(current.children, i, child)
+			path = append(path, child)
+
+			break
+		}
+
+		shared := commonPrefix(rest, child.label)
+		if shared < len(child.label) {
+			child = child.split(shared)
+			current.children[i] = child
+		}
+
+		current, rest = child, rest[shared:]
+		path = append(path, current)
+	}
+
+	target := path[len(path)-1]
+	isNew := !target.terminal
+	target.terminal = true
+	target.value = value
+	target.count++
+
+	for _, n := range path {
+		n.maxCount = max(n.maxCount, target.count)
+	}
+
+	if isNew {
+		t.len++
+	}
+
+	return isNew
+}
+
+// Get returns the value for the key and true, or the zero value and false if the key
+// isn't present.
+func (t *Trie[V]) Get(key string) (V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	n := t.find(key)
+	if n == nil || !n.terminal {
+		var zero V
+
+		return zero, false
+	}
+
+	return n.value, true
+}
+
+// Count returns the number of times the key was inserted since it was last deleted, or 0 if
+// it isn't present.
+func (t *Trie[V]) Count(key string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	n := t.find(key)
+	if n == nil {
+		return 0
+	}
+
+	return n.count
+}
+
+// Delete removes the key and its count. It returns false if the key isn't present.
+func (t *Trie[V]) Delete(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	// path holds the nodes from the root to the key's node and indexes the position of each
+	// one among its parent's children.
+	path := []*node[V]{t.root}
+	indexes := []int{0}
+	current, rest := t.root, key
+
+	for rest != "" {
+		i, child := current.child(rest[0])
+		if child == nil || !strings.HasPrefix(rest, child.label) {
+			return false
+		}
+
+		current, rest = child, rest[len(child.label):]
+		path = append(path, current)
+		indexes = append(indexes, i)
+	}
+
+	if !current.terminal {
+		return false
+	}
+
+	var zero V
+
+	current.terminal = false
+	current.value = zero
+	current.count = 0
+
+	// Walk back up, dropping or merging the nodes left without a key and fixing maxCount.
+	for i := len(path) - 1; i >= 0; i-- {
+		path[i].updateMaxCount()
+
+		if i > 0 {
+			path[i-1].compact(indexes[i])
+		}
+	}
+
+	t.len--
+
+	return true
+}
+
+// WithPrefix returns an iterator over the keys that start with the prefix and their values
+// in sorted order. The keys are read under the lock when iteration starts, so the trie may
+// be modified while iterating.
+func (t *Trie[V]) WithPrefix(prefix string) iter.Seq2[string, V] {
+	return func(yield func(string, V) bool) {
+		for _, m := range t.snapshot(prefix) {
+			if !yield(m.Key, m.Value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over the keys and values in sorted order.
+// The keys are read under the lock when iteration starts, so the trie may be modified
+// while iterating.
+func (t *Trie[V]) All() iter.Seq2[string, V] {
+	return t.WithPrefix("")
+}
+
+// LongestPrefix returns the longest key that is a prefix of s and its value, or false if no
+// key is. It answers routing-table lookups, where the most specific route wins.
+func (t *Trie[V]) LongestPrefix(s string) (string, V, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var (
+		longest  *node[V]
+		length   int
+		consumed int
+	)
+
+	for current := t.root; current != nil; {
+		if current.terminal {
+			longest, length = current, consumed
+		}
+
+		rest := s[consumed:]
+		if rest == "" {
+			break
+		}
+
+		_, child := current.child(rest[0])
+		if child == nil || !strings.HasPrefix(rest, child.label) {
+			break
+		}
+
+		current = child
+		consumed += len(child.label)
+	}
+
+	if longest == nil {
+		var zero V
+
+		return "", zero, false
+	}
+
+	return s[:length], longest.value, true
+}
+
+// Clear removes every key.
+func (t *Trie[V]) Clear() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.root = &node[V]{}
+	t.len = 0
+}
+
+// IsEmpty returns true if the trie holds no keys.
+func (t *Trie[V]) IsEmpty() bool {
+	return t.Len() == 0
+}
+
+// Len returns the number of keys.
+func (t *Trie[V]) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.len
+}
+
+// find returns the node whose path spells the key, or nil if there is none.
+func (t *Trie[V]) find(key string) *node[V] {
+	current, rest := t.root, key
+
+	for rest != "" {
+		_, child := current.child(rest[0])
+		if child == nil || !strings.HasPrefix(rest, child.label) {
+			return nil
+		}
+
+		current, rest = child, rest[len(child.label):]
+	}
+
+	return current
+}
+
+// seek returns the topmost node whose path starts with the prefix, along with that path,
+// or nil if no key starts with the prefix. The prefix may end partway through the node's label.
+func (t *Trie[V]) seek(prefix string) (*node[V], string) {
+	current, rest := t.root, prefix
+
+	for rest != "" {
+		_, child := current.child(rest[0])
+		if child == nil {
+			return nil, ""
+		}
+
+		if strings.HasPrefix(child.label, rest) {
+			return child, prefix + child.label[len(rest):]
+		}
+
+		if !strings.HasPrefix(rest, child.label) {
+			return nil, ""
+		}
+
+		current, rest = child, rest[len(child.label):]
+	}
+
+	return current, prefix
+}
+
+// snapshot copies the keys starting with the prefix, with their values and counts, in
+// sorted order.
+func (t *Trie[V]) snapshot(prefix string) []Match[V] {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	start, path := t.seek(prefix)
+	if start == nil {
+		return nil
+	}
+
+	var matches []Match[V]
+
+	start.walk(path, func(key string, n *node[V]) {
+		matches = append(matches, Match[V]{Key: key, Value: n.value, Count: n.count})
+	})
+
+	return matches
+}
+
+// walk calls visit with every key in the subtree and its node in sorted order, where path is
+// the node's key. A key sorts before the longer keys it is a prefix of.
+func (n *node[V]) walk(path string, visit func(string, *node[V])) {
+	if n.terminal {
+		visit(path, n)
+	}
+
+	for _, child := range n.children {
+		child.walk(path+child.label, visit)
+	}
+}
+
+// child returns the child whose label starts with the byte, or nil and the index at which
+// such a child would be inserted.
+func (n *node[V]) child(first byte) (int, *node[V]) {
+	i, found := slices.BinarySearchFunc(n.children, first, func(child *node[V], first byte) int {
+		return cmp.Compare(child.label[0], first)
+	})
+
+	if !found {
+		return i, nil
+	}
+
+	return i, n.children[i]
+}
+
+// split cuts the node's label after the first length bytes, putting a new node with the
+// first part in the node's place, and returns the new node.
+func (n *node[V]) split(length int) *node[V] {
+	parent := &node[V]{
+		label:    n.label[:length],
+		children: []*node[V]{n},
+		maxCount: n.maxCount,
+	}
+	n.label = n.label[length:]
+
+	return parent
+}
+
+// compact removes the child at index i if it holds no key and no children, or merges it into
+// its only child if it holds no key, keeping the tree compressed.
+func (n *node[V]) compact(i int) {
+	child := n.children[i]
+	if child.terminal {
+		return
+	}
+
+	switch len(child.children) {
+	case 0:
+		n.children = slices.Delete(n.children, i, i+1)
+	case 1:
+		grandchild := child.children[0]
+		grandchild.label = child.label + grandchild.label
+		n.children[i] = grandchild
+	}
+}
+
+// updateMaxCount recomputes the node's maxCount from its own count and its children's.
+func (n *node[V]) updateMaxCount() {
+	n.maxCount = n.count
+
+	for _, child := range n.children {
+		n.maxCount = max(n.maxCount, child.maxCount)
+	}
+}
+
+// commonPrefix returns the length of the longest common prefix of a and b in bytes.
+func commonPrefix(a, b string) int {
+	length := min(len(a), len(b))
+
+	for i := range length {
+		if a[i] != b[i] {
+			return i
+		}
+	}
+
+	return length
+}
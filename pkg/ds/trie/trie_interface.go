@@ -0,0 +1,37 @@
+// Package trie implements the trie data structure.
+package trie
+
+import (
+	"errors"
+	"iter"
+)
+
+// ErrInvalidCount is an error indicating a negative number of results was requested.
+var ErrInvalidCount = errors.New("invalid count")
+
+// StringMapper defines the basic operations for a map from strings.
+type StringMapper[V any] interface {
+	Insert(key string, value V) bool
+	Get(key string) (V, bool)
+	Delete(key string) bool
+	All() iter.Seq2[string, V]
+	Clear()
+	IsEmpty() bool
+	Len() int
+}
+
+// PrefixQuerier defines the queries that find keys by their prefixes, and the insert counts
+// TopK ranks them by.
+type PrefixQuerier[V any] interface {
+	Count(key string) int
+	WithPrefix(prefix string) iter.Seq2[string, V]
+	LongestPrefix(s string) (string, V, bool)
+	TopK(prefix string, k int) ([]Match[V], error)
+}
+
+// PrefixMapper combines the StringMapper and PrefixQuerier operations for a map from strings
+// that answers prefix queries.
+type PrefixMapper[V any] interface {
+	StringMapper[V]
+	PrefixQuerier[V]
+}
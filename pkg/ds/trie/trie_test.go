@@ -0,0 +1,301 @@
+package trie_test
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/ds/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type TrieTestSuite struct {
+	suite.Suite
+	trie *trie.Trie[int]
+}
+
+func TestTrieTestSuite(t *testing.T) {
+	suite.Run(t, new(TrieTestSuite))
+}
+
+func (suite *TrieTestSuite) SetupTest() {
+	suite.trie = trie.New[int]()
+}
+
+func (suite *TrieTestSuite) TearDownTest() {
+	assert.NoError(suite.T(), suite.trie.CheckInvariants())
+}
+
+// fill inserts the keys, each with its length as the value.
+func (suite *TrieTestSuite) fill(keys ...string) {
+	for _, key := range keys {
+		suite.trie.Insert(key, len(key))
+	}
+}
+
+// keys collects the keys produced by an iterator.
+func keys(seq func(yield func(string, int) bool)) []string {
+	var collected []string
+
+	for key := range seq {
+		collected = append(collected, key)
+	}
+
+	return collected
+}
+
+// randomKey returns a key of up to 4 pieces drawn from ASCII letters and multi-byte
+// characters, several of which share their first byte.
+func randomKey(rng *rand.Rand) string {
+	pieces := []string{"a", "b", "ab", "é", "è", "ê", "日", "本", "😀", "😁"}
+
+	var key strings.Builder
+
+	for range rng.IntN(5) {
+		key.WriteString(pieces[rng.IntN(len(pieces))])
+	}
+
+	return key.String()
+}
+
+func (suite *TrieTestSuite) TestNew() {
+	assert.True(suite.T(), suite.trie.IsEmpty())
+	assert.Equal(suite.T(), 0, suite.trie.Len())
+	assert.Empty(suite.T(), keys(suite.trie.All()))
+}
+
+func (suite *TrieTestSuite) TestInsertAndGet() {
+	assert.True(suite.T(), suite.trie.Insert("team", 1))
+	assert.True(suite.T(), suite.trie.Insert("tea", 2))
+	assert.True(suite.T(), suite.trie.Insert("ten", 3))
+
+	value, found := suite.trie.Get("tea")
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), 2, value)
+
+	// "te" is a node on the way to the keys, but not a key.
+	_, found = suite.trie.Get("te")
+	assert.False(suite.T(), found)
+
+	_, found = suite.trie.Get("teams")
+	assert.False(suite.T(), found)
+	assert.Equal(suite.T(), 3, suite.trie.Len())
+}
+
+func (suite *TrieTestSuite) TestInsertReplacesAndCounts() {
+	assert.True(suite.T(), suite.trie.Insert("go", 1))
+	assert.False(suite.T(), suite.trie.Insert("go", 2))
+	assert.False(suite.T(), suite.trie.Insert("go", 3))
+
+	value, _ := suite.trie.Get("go")
+	assert.Equal(suite.T(), 3, value)
+	assert.Equal(suite.T(), 3, suite.trie.Count("go"))
+	assert.Equal(suite.T(), 0, suite.trie.Count("g"))
+	assert.Equal(suite.T(), 1, suite.trie.Len())
+}
+
+func (suite *TrieTestSuite) TestEmptyKey() {
+	suite.fill("", "a")
+
+	value, found := suite.trie.Get("")
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), 0, value)
+	assert.Equal(suite.T(), []string{"", "a"}, keys(suite.trie.All()))
+
+	assert.True(suite.T(), suite.trie.Delete(""))
+	assert.Equal(suite.T(), []string{"a"}, keys(suite.trie.All()))
+}
+
+func (suite *TrieTestSuite) TestDelete() {
+	suite.fill("team", "tea", "ten", "to")
+
+	assert.True(suite.T(), suite.trie.Delete("tea"))
+	assert.False(suite.T(), suite.trie.Delete("tea"))
+	assert.False(suite.T(), suite.trie.Delete("te"))
+	assert.False(suite.T(), suite.trie.Delete("teams"))
+	assert.Equal(suite.T(), 0, suite.trie.Count("tea"))
+
+	// Deleting merges the nodes the key no longer needs, which CheckInvariants verifies.
+	require.NoError(suite.T(), suite.trie.CheckInvariants())
+	assert.Equal(suite.T(), []string{"team", "ten", "to"}, keys(suite.trie.All()))
+
+	assert.True(suite.T(), suite.trie.Delete("team"))
+	assert.True(suite.T(), suite.trie.Delete("ten"))
+	assert.True(suite.T(), suite.trie.Delete("to"))
+	assert.True(suite.T(), suite.trie.IsEmpty())
+}
+
+func (suite *TrieTestSuite) TestWithPrefix() {
+	suite.fill("romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus")
+
+	assert.Equal(suite.T(), []string{"romane", "romanus", "romulus"}, keys(suite.trie.WithPrefix("rom")))
+	assert.Equal(suite.T(), []string{"rubicon", "rubicundus"}, keys(suite.trie.WithPrefix("rubic")))
+	assert.Equal(suite.T(), []string{"ruber"}, keys(suite.trie.WithPrefix("ruber")))
+	assert.Len(suite.T(), keys(suite.trie.WithPrefix("")), 7)
+	assert.Empty(suite.T(), keys(suite.trie.WithPrefix("rubes")))
+	assert.Empty(suite.T(), keys(suite.trie.WithPrefix("rubiconic")))
+}
+
+func (suite *TrieTestSuite) TestWithPrefixStopsEarly() {
+	suite.fill("a", "ab", "abc")
+
+	var visited []string
+
+	for key := range suite.trie.WithPrefix("a") {
+		visited = append(visited, key)
+		if key == "ab" {
+			break
+		}
+	}
+
+	assert.Equal(suite.T(), []string{"a", "ab"}, visited)
+}
+
+func (suite *TrieTestSuite) TestLongestPrefix() {
+	suite.fill("10", "10.1", "10.1.2", "192.168")
+
+	tests := map[string]string{
+		"10.1.2.3":    "10.1.2",
+		"10.1.3.4":    "10.1",
+		"10.2":        "10",
+		"10":          "10",
+		"192.168.0.1": "192.168",
+	}
+
+	for s, expected := range tests {
+		key, value, found := suite.trie.LongestPrefix(s)
+		assert.True(suite.T(), found, s)
+		assert.Equal(suite.T(), expected, key, s)
+		assert.Equal(suite.T(), len(expected), value, s)
+	}
+
+	_, _, found := suite.trie.LongestPrefix("192.16")
+	assert.False(suite.T(), found)
+
+	_, _, found = suite.trie.LongestPrefix("")
+	assert.False(suite.T(), found)
+}
+
+func (suite *TrieTestSuite) TestUTF8Keys() {
+	// "é", "è" and "ê" all start with the byte 0xC3, and the emoji share three leading bytes.
+	suite.fill("café", "cafè", "cafê", "日本", "日本語", "😀", "😁")
+
+	assert.Equal(suite.T(), []string{"cafè", "café", "cafê"}, keys(suite.trie.WithPrefix("caf")))
+	assert.Equal(suite.T(), []string{"cafè"}, keys(suite.trie.WithPrefix("cafè")))
+	assert.Equal(suite.T(), []string{"日本", "日本語"}, keys(suite.trie.WithPrefix("日")))
+	assert.Equal(suite.T(), []string{"😀"}, keys(suite.trie.WithPrefix("😀")))
+
+	key, _, found := suite.trie.LongestPrefix("日本語です")
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), "日本語", key)
+
+	assert.True(suite.T(), suite.trie.Delete("cafè"))
+
+	value, found := suite.trie.Get("café")
+	assert.True(suite.T(), found)
+	assert.Equal(suite.T(), len("café"), value)
+
+	// Every key comes back out whole.
+	for key := range suite.trie.All() {
+		assert.True(suite.T(), strings.ToValidUTF8(key, "") == key, key)
+	}
+}
+
+func (suite *TrieTestSuite) TestInvalidUTF8Keys() {
+	suite.fill("\xff", "\xfe\xff", "\xc3")
+
+	assert.Equal(suite.T(), []string{"\xc3", "\xfe\xff", "\xff"}, keys(suite.trie.All()))
+
+	_, found := suite.trie.Get("\xfe")
+	assert.False(suite.T(), found)
+}
+
+func (suite *TrieTestSuite) TestClear() {
+	suite.fill("a", "b")
+
+	suite.trie.Clear()
+
+	assert.True(suite.T(), suite.trie.IsEmpty())
+	assert.Empty(suite.T(), keys(suite.trie.All()))
+}
+
+func (suite *TrieTestSuite) TestAgainstModel() {
+	rng := rand.New(rand.NewPCG(8, 9))
+	model := map[string]int{}
+
+	for step := range 5000 {
+		key := randomKey(rng)
+
+		if rng.IntN(3) == 0 {
+			_, existed := model[key]
+			delete(model, key)
+
+			require.Equal(suite.T(), existed, suite.trie.Delete(key))
+		} else {
+			value := rng.IntN(100)
+			_, existed := model[key]
+			model[key] = value
+
+			require.Equal(suite.T(), !existed, suite.trie.Insert(key, value))
+		}
+
+		require.NoError(suite.T(), suite.trie.CheckInvariants(), "step %d", step)
+	}
+
+	sorted := slices.Sorted(maps.Keys(model))
+	assert.Equal(suite.T(), sorted, keys(suite.trie.All()))
+	assert.Equal(suite.T(), len(model), suite.trie.Len())
+
+	for range 200 {
+		s := randomKey(rng)
+
+		var withPrefix []string
+
+		longest, hasLongest := "", false
+
+		for _, key := range sorted {
+			if strings.HasPrefix(key, s) {
+				withPrefix = append(withPrefix, key)
+			}
+
+			if strings.HasPrefix(s, key) && len(key) >= len(longest) {
+				longest, hasLongest = key, true
+			}
+		}
+
+		assert.Equal(suite.T(), withPrefix, keys(suite.trie.WithPrefix(s)), "prefix %q", s)
+
+		key, value, found := suite.trie.LongestPrefix(s)
+		assert.Equal(suite.T(), hasLongest, found, "longest prefix of %q", s)
+		assert.Equal(suite.T(), longest, key, "longest prefix of %q", s)
+		assert.Equal(suite.T(), model[longest], value, "longest prefix of %q", s)
+	}
+}
+
+func (suite *TrieTestSuite) TestConcurrentInserts() {
+	var wg sync.WaitGroup
+
+	for worker := range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range 100 {
+				key := strings.Repeat("é", worker+1) + string(rune('a'+i%26)) + string(rune('0'+i/26))
+				suite.trie.Insert(key, i)
+				suite.trie.Get(key)
+				suite.trie.LongestPrefix(key)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(suite.T(), 800, suite.trie.Len())
+}
@@ -0,0 +1,69 @@
+// Package undo implements an undo/redo history of commands.
+package undo
+
+import (
+	"errors"
+	"fmt"
+)
+
+// funcCommand is a Command built from a pair of functions.
+type funcCommand struct {
+	do, undo func() error
+}
+
+// NewCommand creates a Command that calls do and undo.
+func NewCommand(do, undo func() error) Command {
+	return &funcCommand{do: do, undo: undo}
+}
+
+// Do calls the do function.
+func (c *funcCommand) Do() error {
+	return c.do()
+}
+
+// Undo calls the undo function.
+func (c *funcCommand) Undo() error {
+	return c.undo()
+}
+
+// group is a Command made of other commands, done in order and undone in reverse order.
+// Transactions are recorded as groups so they undo and redo as one step.
+type group struct {
+	commands []Command
+}
+
+// Do does every command in order. If one fails, the ones already done are undone again so
+// the group is done entirely or not at all.
+func (g *group) Do() error {
+	for i, command := range g.commands {
+		if err := command.Do(); err != nil {
+			return errors.Join(err, undoAll(g.commands[:i]))
+		}
+	}
+
+	return nil
+}
+
+// Undo undoes every command in reverse order. If one fails, the ones already undone are
+// done again so the group stays done.
+func (g *group) Undo() error {
+	return undoAll(g.commands)
+}
+
+// undoAll undoes the commands in reverse order. If one fails, the ones it already undid are
+// done again, and the failure is returned.
+func undoAll(commands []Command) error {
+	for i := len(commands) - 1; i >= 0; i-- {
+		if err := commands[i].Undo(); err != nil {
+			for _, command := range commands[i+1:] {
+				if redoErr := command.Do(); redoErr != nil {
+					return errors.Join(err, fmt.Errorf("restoring after a failed undo: %w", redoErr))
+				}
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
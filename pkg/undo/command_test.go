@@ -0,0 +1,97 @@
+package undo_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/undo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCommand(t *testing.T) {
+	calls := []string{}
+
+	command := undo.NewCommand(
+		func() error { calls = append(calls, "do"); return nil },
+		func() error { calls = append(calls, "undo"); return errFailed },
+	)
+
+	require.NoError(t, command.Do())
+	require.ErrorIs(t, command.Undo(), errFailed)
+	assert.Equal(t, []string{"do", "undo"}, calls)
+}
+
+// TestTransactionRedoIsAllOrNothing checks that a transaction whose redo fails partway is
+// undone again, leaving it to redo later.
+func TestTransactionRedoIsAllOrNothing(t *testing.T) {
+	history := undo.New()
+	doc := &document{}
+	failDo, failUndo := false, false
+
+	require.NoError(t, history.Begin())
+	require.NoError(t, history.Execute(doc.appendText("a")))
+	require.NoError(t, history.Execute(failing(&failDo, &failUndo)))
+	require.NoError(t, history.Commit())
+	require.NoError(t, history.Undo())
+
+	failDo = true
+	require.ErrorIs(t, history.Redo(), errFailed)
+	assert.Empty(t, doc.String())
+	assert.True(t, history.CanRedo())
+
+	failDo = false
+	require.NoError(t, history.Redo())
+	assert.Equal(t, "a", doc.String())
+}
+
+// TestTransactionUndoIsAllOrNothing checks that a transaction whose undo fails partway is done
+// again, leaving it to undo later.
+func TestTransactionUndoIsAllOrNothing(t *testing.T) {
+	history := undo.New()
+	doc := &document{}
+	failDo, failUndo := false, false
+
+	require.NoError(t, history.Begin())
+	require.NoError(t, history.Execute(failing(&failDo, &failUndo)))
+	require.NoError(t, history.Execute(doc.appendText("a")))
+	require.NoError(t, history.Commit())
+
+	failUndo = true
+	require.ErrorIs(t, history.Undo(), errFailed)
+	assert.Equal(t, "a", doc.String())
+	assert.True(t, history.CanUndo())
+}
+
+// TestFailedRestoreIsReported checks that an error restoring a half-undone group is joined
+// to the original failure.
+func TestFailedRestoreIsReported(t *testing.T) {
+	errRestore := errors.New("restore failed")
+	history := undo.New()
+	done := false
+
+	restoreFails := undo.NewCommand(
+		func() error {
+			if done {
+				return errRestore
+			}
+
+			done = true
+
+			return nil
+		},
+		func() error { return nil },
+	)
+	failDo, failUndo := false, false
+
+	require.NoError(t, history.Begin())
+	require.NoError(t, history.Execute(failing(&failDo, &failUndo)))
+	require.NoError(t, history.Execute(restoreFails))
+	require.NoError(t, history.Commit())
+
+	failUndo = true
+	err := history.Undo()
+
+	require.ErrorIs(t, err, errFailed)
+	require.ErrorIs(t, err, errRestore)
+}
@@ -0,0 +1,213 @@
+// Package undo implements an undo/redo history of commands.
+package undo
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/dqfan2012/playground/pkg/ds/stack"
+)
+
+// History records executed commands so they can be undone and redone.
+// Mutex ensures the implementation of History is thread-safe. Commands run under the lock,
+// so they must not call back into the History.
+type History struct {
+	// undo holds the commands that can be undone, the most recent on top.
+	undo *stack.Stack[Command]
+	// redo holds the undone commands that can be redone, the most recently undone on top.
+	redo *stack.Stack[Command]
+	// capacity is the most commands undo holds, or 0 for no limit.
+	capacity int
+	// transaction collects the commands executed since Begin, or is nil outside a transaction.
+	transaction *group
+	mu          sync.Mutex
+}
+
+// New creates a new history without a capacity limit.
+func New() *History {
+	return &History{
+		undo: stack.New[Command](),
+		redo: stack.New[Command](),
+	}
+}
+
+// NewWithCapacity creates a new history that keeps at most capacity commands to undo,
+// dropping the oldest ones first.
+func NewWithCapacity(capacity int) (*History, error) {
+	if capacity < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidCapacity, capacity)
+	}
+
+	history := New()
+	history.capacity = capacity
+
+	return history, nil
+}
+
+// Execute does the command and records it. Recording a new command clears the commands
+// that could be redone. Inside a transaction the command joins the transaction instead.
+// If the command fails nothing is recorded.
+func (h *History) Execute(command Command) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err := command.Do(); err != nil {
+		return err
+	}
+
+	if h.transaction != nil {
+		h.transaction.commands = append(h.transaction.commands, command)
+
+		return nil
+	}
+
+	h.record(command)
+
+	return nil
+}
+
+// Undo undoes the most recent command and makes it available to Redo. If the command fails
+// to undo it stays in the history.
+func (h *History) Undo() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.transaction != nil {
+		return ErrTransactionInProgress
+	}
+
+	command, err := h.undo.Pop()
+	if err != nil {
+		return ErrNothingToUndo
+	}
+
+	if err := (*command).Undo(); err != nil {
+		h.undo.Push(*command)
+
+		return err
+	}
+
+	h.redo.Push(*command)
+
+	return nil
+}
+
+// Redo does the most recently undone command again. If the command fails it stays available
+// to Redo.
+func (h *History) Redo() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.transaction != nil {
+		return ErrTransactionInProgress
+	}
+
+	command, err := h.redo.Pop()
+	if err != nil {
+		return ErrNothingToRedo
+	}
+
+	if err := (*command).Do(); err != nil {
+		h.redo.Push(*command)
+
+		return err
+	}
+
+	h.undo.Push(*command)
+	h.trim()
+
+	return nil
+}
+
+// Begin opens a transaction. The commands executed until Commit are recorded as a single
+// command that is undone and redone as a whole. Transactions don't nest.
+func (h *History) Begin() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.transaction != nil {
+		return ErrTransactionInProgress
+	}
+
+	h.transaction = &group{}
+
+	return nil
+}
+
+// Commit closes the open transaction and records its commands as a single command.
+// An empty transaction records nothing.
+func (h *History) Commit() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.transaction == nil {
+		return ErrNoTransaction
+	}
+
+	transaction := h.transaction
+	h.transaction = nil
+
+	if len(transaction.commands) > 0 {
+		h.record(transaction)
+	}
+
+	return nil
+}
+
+// Rollback closes the open transaction, undoing its commands in reverse order without
+// recording them. If a command fails to undo, the commands already undone are done again
+// and the transaction stays open, so it can be rolled back again or committed.
+func (h *History) Rollback() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.transaction == nil {
+		return ErrNoTransaction
+	}
+
+	if err := h.transaction.Undo(); err != nil {
+		return err
+	}
+
+	h.transaction = nil
+
+	return nil
+}
+
+// CanUndo returns true if there is a command to undo.
+func (h *History) CanUndo() bool {
+	return !h.undo.IsEmpty()
+}
+
+// CanRedo returns true if there is a command to redo.
+func (h *History) CanRedo() bool {
+	return !h.redo.IsEmpty()
+}
+
+// Clear forgets every recorded command without undoing any of them. An open transaction
+// stays open.
+func (h *History) Clear() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.undo.Clear()
+	h.redo.Clear()
+}
+
+// record pushes a done command onto the undo stack and clears the redo stack, since the
+// undone commands no longer apply on top of the new one. The lock must be held.
+func (h *History) record(command Command) {
+	h.undo.Push(command)
+	h.redo.Clear()
+	h.trim()
+}
+
+// trim drops the oldest commands beyond the capacity. The lock must be held.
+func (h *History) trim() {
+	if h.capacity == 0 || h.undo.Len() <= h.capacity {
+		return
+	}
+
+	// The stack holds at least the excess, so dropping it can't fail.
+	_ = h.undo.DropBottom(h.undo.Len() - h.capacity)
+}
@@ -0,0 +1,317 @@
+package undo_test
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/dqfan2012/playground/pkg/undo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+// errFailed is returned by commands that are told to fail.
+var errFailed = errors.New("failed")
+
+// document is a tiny text buffer edited through commands.
+type document struct {
+	text strings.Builder
+	mu   sync.Mutex
+}
+
+// String returns the document's text.
+func (d *document) String() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.text.String()
+}
+
+// appendText returns a command that appends the text and removes it again.
+func (d *document) appendText(text string) undo.Command {
+	return undo.NewCommand(
+		func() error {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+
+			d.text.WriteString(text)
+
+			return nil
+		},
+		func() error {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+
+			current := d.text.String()
+			d.text.Reset()
+			d.text.WriteString(strings.TrimSuffix(current, text))
+
+			return nil
+		},
+	)
+}
+
+// failing returns a command whose Do or Undo fails while the matching flag is set.
+func failing(failDo, failUndo *bool) undo.Command {
+	return undo.NewCommand(
+		func() error {
+			if *failDo {
+				return errFailed
+			}
+
+			return nil
+		},
+		func() error {
+			if *failUndo {
+				return errFailed
+			}
+
+			return nil
+		},
+	)
+}
+
+type HistoryTestSuite struct {
+	suite.Suite
+	history  *undo.History
+	document *document
+}
+
+func TestHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(HistoryTestSuite))
+}
+
+func (suite *HistoryTestSuite) SetupTest() {
+	suite.history = undo.New()
+	suite.document = &document{}
+}
+
+// execute appends the texts, each as its own command.
+func (suite *HistoryTestSuite) execute(texts ...string) {
+	for _, text := range texts {
+		require.NoError(suite.T(), suite.history.Execute(suite.document.appendText(text)))
+	}
+}
+
+func (suite *HistoryTestSuite) TestEmpty() {
+	assert.False(suite.T(), suite.history.CanUndo())
+	assert.False(suite.T(), suite.history.CanRedo())
+	assert.ErrorIs(suite.T(), suite.history.Undo(), undo.ErrNothingToUndo)
+	assert.ErrorIs(suite.T(), suite.history.Redo(), undo.ErrNothingToRedo)
+}
+
+func (suite *HistoryTestSuite) TestUndoAndRedo() {
+	suite.execute("a", "b", "c")
+
+	require.NoError(suite.T(), suite.history.Undo())
+	require.NoError(suite.T(), suite.history.Undo())
+	assert.Equal(suite.T(), "a", suite.document.String())
+	assert.True(suite.T(), suite.history.CanRedo())
+
+	require.NoError(suite.T(), suite.history.Redo())
+	assert.Equal(suite.T(), "ab", suite.document.String())
+
+	require.NoError(suite.T(), suite.history.Redo())
+	assert.Equal(suite.T(), "abc", suite.document.String())
+	assert.ErrorIs(suite.T(), suite.history.Redo(), undo.ErrNothingToRedo)
+}
+
+func (suite *HistoryTestSuite) TestNewCommandClearsRedo() {
+	suite.execute("a", "b")
+	require.NoError(suite.T(), suite.history.Undo())
+
+	suite.execute("c")
+
+	assert.False(suite.T(), suite.history.CanRedo())
+	assert.Equal(suite.T(), "ac", suite.document.String())
+}
+
+func (suite *HistoryTestSuite) TestFailedCommandIsNotRecorded() {
+	failDo, failUndo := true, false
+
+	err := suite.history.Execute(failing(&failDo, &failUndo))
+
+	assert.ErrorIs(suite.T(), err, errFailed)
+	assert.False(suite.T(), suite.history.CanUndo())
+}
+
+func (suite *HistoryTestSuite) TestFailedUndoKeepsCommand() {
+	failDo, failUndo := false, true
+	require.NoError(suite.T(), suite.history.Execute(failing(&failDo, &failUndo)))
+
+	assert.ErrorIs(suite.T(), suite.history.Undo(), errFailed)
+	assert.True(suite.T(), suite.history.CanUndo())
+
+	failUndo = false
+	require.NoError(suite.T(), suite.history.Undo())
+	assert.True(suite.T(), suite.history.CanRedo())
+}
+
+func (suite *HistoryTestSuite) TestFailedRedoKeepsCommand() {
+	failDo, failUndo := false, false
+	require.NoError(suite.T(), suite.history.Execute(failing(&failDo, &failUndo)))
+	require.NoError(suite.T(), suite.history.Undo())
+
+	failDo = true
+	assert.ErrorIs(suite.T(), suite.history.Redo(), errFailed)
+	assert.True(suite.T(), suite.history.CanRedo())
+	assert.False(suite.T(), suite.history.CanUndo())
+}
+
+func (suite *HistoryTestSuite) TestTransactionUndoesAsOne() {
+	suite.execute("a")
+
+	require.NoError(suite.T(), suite.history.Begin())
+	suite.execute("b", "c")
+	require.NoError(suite.T(), suite.history.Commit())
+
+	assert.Equal(suite.T(), "abc", suite.document.String())
+
+	require.NoError(suite.T(), suite.history.Undo())
+	assert.Equal(suite.T(), "a", suite.document.String())
+
+	require.NoError(suite.T(), suite.history.Redo())
+	assert.Equal(suite.T(), "abc", suite.document.String())
+}
+
+func (suite *HistoryTestSuite) TestEmptyTransactionRecordsNothing() {
+	require.NoError(suite.T(), suite.history.Begin())
+	require.NoError(suite.T(), suite.history.Commit())
+
+	assert.False(suite.T(), suite.history.CanUndo())
+}
+
+func (suite *HistoryTestSuite) TestRollback() {
+	suite.execute("a")
+
+	require.NoError(suite.T(), suite.history.Begin())
+	suite.execute("b", "c")
+	require.NoError(suite.T(), suite.history.Rollback())
+
+	assert.Equal(suite.T(), "a", suite.document.String())
+
+	// Only the command before the transaction is left to undo.
+	require.NoError(suite.T(), suite.history.Undo())
+	assert.False(suite.T(), suite.history.CanUndo())
+}
+
+func (suite *HistoryTestSuite) TestFailedRollbackKeepsTransactionOpen() {
+	failDo, failUndo := false, true
+
+	require.NoError(suite.T(), suite.history.Begin())
+	require.NoError(suite.T(), suite.history.Execute(failing(&failDo, &failUndo)))
+	suite.execute("a")
+
+	assert.ErrorIs(suite.T(), suite.history.Rollback(), errFailed)
+
+	// The command undone before the failure was done again.
+	assert.Equal(suite.T(), "a", suite.document.String())
+
+	require.NoError(suite.T(), suite.history.Commit())
+	assert.True(suite.T(), suite.history.CanUndo())
+}
+
+func (suite *HistoryTestSuite) TestTransactionErrors() {
+	assert.ErrorIs(suite.T(), suite.history.Commit(), undo.ErrNoTransaction)
+	assert.ErrorIs(suite.T(), suite.history.Rollback(), undo.ErrNoTransaction)
+
+	suite.execute("a")
+	require.NoError(suite.T(), suite.history.Begin())
+
+	assert.ErrorIs(suite.T(), suite.history.Begin(), undo.ErrTransactionInProgress)
+	assert.ErrorIs(suite.T(), suite.history.Undo(), undo.ErrTransactionInProgress)
+	assert.ErrorIs(suite.T(), suite.history.Redo(), undo.ErrTransactionInProgress)
+}
+
+func (suite *HistoryTestSuite) TestCapacityDropsOldest() {
+	history, err := undo.NewWithCapacity(2)
+	require.NoError(suite.T(), err)
+
+	suite.history = history
+	suite.execute("a", "b", "c")
+
+	require.NoError(suite.T(), suite.history.Undo())
+	require.NoError(suite.T(), suite.history.Undo())
+	assert.ErrorIs(suite.T(), suite.history.Undo(), undo.ErrNothingToUndo)
+	assert.Equal(suite.T(), "a", suite.document.String())
+
+	// Redoing refills the history up to the capacity.
+	require.NoError(suite.T(), suite.history.Redo())
+	require.NoError(suite.T(), suite.history.Redo())
+	assert.Equal(suite.T(), "abc", suite.document.String())
+}
+
+func (suite *HistoryTestSuite) TestFullHistoryKeepsNewest() {
+	const capacity = 3
+
+	history, err := undo.NewWithCapacity(capacity)
+	require.NoError(suite.T(), err)
+
+	counter := 0
+
+	for range 10_000 {
+		require.NoError(suite.T(), history.Execute(undo.NewCommand(
+			func() error { counter++; return nil },
+			func() error { counter--; return nil },
+		)))
+	}
+
+	for range capacity {
+		require.NoError(suite.T(), history.Undo())
+	}
+
+	assert.ErrorIs(suite.T(), history.Undo(), undo.ErrNothingToUndo)
+	assert.Equal(suite.T(), 10_000-capacity, counter)
+}
+
+func (suite *HistoryTestSuite) TestInvalidCapacity() {
+	for _, capacity := range []int{-1, 0} {
+		history, err := undo.NewWithCapacity(capacity)
+
+		assert.Nil(suite.T(), history)
+		assert.ErrorIs(suite.T(), err, undo.ErrInvalidCapacity)
+	}
+}
+
+func (suite *HistoryTestSuite) TestClear() {
+	suite.execute("a", "b")
+	require.NoError(suite.T(), suite.history.Undo())
+
+	suite.history.Clear()
+
+	assert.False(suite.T(), suite.history.CanUndo())
+	assert.False(suite.T(), suite.history.CanRedo())
+	assert.Equal(suite.T(), "a", suite.document.String())
+}
+
+func (suite *HistoryTestSuite) TestConcurrentExecuteAndUndo() {
+	counter := 0
+
+	increment := undo.NewCommand(
+		func() error { counter++; return nil },
+		func() error { counter--; return nil },
+	)
+
+	var wg sync.WaitGroup
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for range 100 {
+				_ = suite.history.Execute(increment)
+				_ = suite.history.Undo()
+				_ = suite.history.Execute(increment)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Commands run under the history's lock, so the counter needs no lock of its own.
+	assert.Equal(suite.T(), 800, counter)
+}
@@ -0,0 +1,29 @@
+// Package undo implements an undo/redo history of commands.
+package undo
+
+import "errors"
+
+var (
+	// ErrNothingToUndo is an error indicating the history has no command to undo.
+	ErrNothingToUndo = errors.New("nothing to undo")
+
+	// ErrNothingToRedo is an error indicating the history has no command to redo.
+	ErrNothingToRedo = errors.New("nothing to redo")
+
+	// ErrTransactionInProgress is an error indicating an operation that isn't allowed while
+	// a transaction is open.
+	ErrTransactionInProgress = errors.New("transaction in progress")
+
+	// ErrNoTransaction is an error indicating there is no open transaction to commit or roll back.
+	ErrNoTransaction = errors.New("no transaction")
+
+	// ErrInvalidCapacity is an error indicating a capacity that isn't positive.
+	ErrInvalidCapacity = errors.New("invalid capacity")
+)
+
+// Command is an action that can be done and undone. Undo must reverse Do, and Do must be
+// safe to call again after Undo so the command can be redone.
+type Command interface {
+	Do() error
+	Undo() error
+}